@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/environment"
+	"strings"
+)
+
+// Errors returned when the arguments do not form a command
+var (
+	ErrUnknownCommand = errors.New("unknown command")
+	ErrMissingFlag    = errors.New("missing required flag")
+)
+
+// Command is a subcommand of the CLI, commands with subcommands do not run themselves
+type Command struct {
+	Name        string
+	Usage       string
+	Subcommands []*Command
+	Run         func(ctx context.Context, app *App, args []string) error
+}
+
+// App carries what commands share: the output and the database connection, which is opened once a command needs it
+type App struct {
+	Output io.Writer
+
+	connection database.Connection
+	disconnect func() error
+}
+
+// AppOpt configures the App during initialization
+type AppOpt func(*App)
+
+// WithDatabase sets the connection the commands use instead of connecting to the database configured by the environment
+func WithDatabase(connection database.Connection) AppOpt {
+	return func(a *App) {
+		a.connection = connection
+	}
+}
+
+// NewApp creates a new instance of App that writes to the output
+func NewApp(output io.Writer, options ...AppOpt) *App {
+	app := &App{Output: output}
+	for _, option := range options {
+		option(app)
+	}
+	return app
+}
+
+// Run runs the command named by the arguments, the usage is written when the arguments do not name a command
+func (a *App) Run(ctx context.Context, args []string) error {
+	command, rest, err := find(Commands(), args)
+	if err != nil {
+		printUsage(a.Output, Commands(), "")
+		return err
+	}
+	return command.Run(ctx, a, rest)
+}
+
+// Database returns the connection to the database configured by the environment, connecting on first use
+func (a *App) Database(ctx context.Context) (database.Connection, error) {
+	if a.connection == nil {
+		connection, err := database.NewService(environment.EnvStr(environment.KeyDbDriver), Dsn(), database.WithConnection(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		a.connection = connection
+		a.disconnect = connection.Disconnect
+	}
+	return a.connection, nil
+}
+
+// Close disconnects from the database when the app connected to it
+func (a *App) Close() error {
+	if a.disconnect == nil {
+		return nil
+	}
+	return a.disconnect()
+}
+
+// Commands returns the commands of the CLI
+func Commands() []*Command {
+	return []*Command{
+		MigrateCommand(),
+		SeedCommand(),
+		UserCommand(),
+		ZoneCommand(),
+	}
+}
+
+// Execute runs the command named by the arguments
+func Execute(ctx context.Context, args []string, output io.Writer) error {
+	app := NewApp(output)
+	defer func() {
+		_ = app.Close()
+	}()
+
+	return app.Run(ctx, args)
+}
+
+// Dsn returns the data source name configured by the environment, assembled from its parts when it is not set
+func Dsn() string {
+	if dsn := environment.EnvStr(environment.KeyDbDsn); dsn != "" {
+		return dsn
+	}
+	return database.CreateDsn(environment.EnvStr(environment.KeyDbUser), environment.EnvStr(environment.KeyDbPassword),
+		environment.EnvStr(environment.KeyDbName), "localhost", environment.EnvStr(environment.KeyDbPort))
+}
+
+// find returns the runnable command named by the leading arguments and the arguments that remain
+func find(commands []*Command, args []string) (*Command, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, fmt.Errorf("%w: none given", ErrUnknownCommand)
+	}
+
+	for _, command := range commands {
+		if command.Name != args[0] {
+			continue
+		}
+		if len(command.Subcommands) == 0 {
+			return command, args[1:], nil
+		}
+		return find(command.Subcommands, args[1:])
+	}
+	return nil, nil, fmt.Errorf("%w: '%s'", ErrUnknownCommand, args[0])
+}
+
+// printUsage writes the usage of every runnable command
+func printUsage(output io.Writer, commands []*Command, prefix string) {
+	if prefix == "" {
+		_, _ = fmt.Fprintln(output, "Usage: cli <command> [flags]")
+	}
+	for _, command := range commands {
+		name := strings.TrimSpace(prefix + " " + command.Name)
+		if len(command.Subcommands) > 0 {
+			printUsage(output, command.Subcommands, name)
+			continue
+		}
+		_, _ = fmt.Fprintf(output, "  %-22s %s\n", name, command.Usage)
+	}
+}
+
+// newFlagSet creates the flag set of a command that reports errors instead of exiting
+func newFlagSet(name string, output io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(output)
+	return flags
+}
+
+// required returns ErrMissingFlag naming the first of the flags that has no value
+func required(flags *flag.FlagSet, names ...string) error {
+	for _, name := range names {
+		if found := flags.Lookup(name); found == nil || found.Value.String() == "" {
+			return fmt.Errorf("%w: --%s", ErrMissingFlag, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package internal_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"shvdg/crazed-conquerer/apps/cli/internal"
+	zoneDomain "shvdg/crazed-conquerer/internal/domains/zone/domain"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CLI", func() {
+	var output *bytes.Buffer
+	var app *internal.App
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		app = internal.NewApp(output)
+	})
+
+	AfterEach(func() {
+		Expect(app.Close()).To(Succeed())
+	})
+
+	When("no command is given", func() {
+		It("should return ErrUnknownCommand and write the usage", func() {
+			err := app.Run(context.Background(), nil)
+			Expect(err).To(MatchError(internal.ErrUnknownCommand))
+			Expect(output.String()).To(ContainSubstring("Usage: cli <command> [flags]"))
+			Expect(output.String()).To(ContainSubstring("user reset-password"))
+			Expect(output.String()).To(ContainSubstring("zone generate"))
+		})
+	})
+
+	When("the command is unknown", func() {
+		It("should return ErrUnknownCommand naming the command", func() {
+			err := app.Run(context.Background(), []string{"unknown"})
+			Expect(err).To(MatchError(internal.ErrUnknownCommand))
+			Expect(err.Error()).To(ContainSubstring("'unknown'"))
+		})
+	})
+
+	When("a command with subcommands is given without one", func() {
+		It("should return ErrUnknownCommand", func() {
+			err := app.Run(context.Background(), []string{"user"})
+			Expect(err).To(MatchError(internal.ErrUnknownCommand))
+		})
+	})
+
+	When("the subcommand is unknown", func() {
+		It("should return ErrUnknownCommand naming the subcommand", func() {
+			err := app.Run(context.Background(), []string{"migrate", "sideways"})
+			Expect(err).To(MatchError(internal.ErrUnknownCommand))
+			Expect(err.Error()).To(ContainSubstring("'sideways'"))
+		})
+	})
+
+	When("a required flag is missing", func() {
+		It("should return ErrMissingFlag for reset-password without a password", func() {
+			err := app.Run(context.Background(), []string{"user", "reset-password", "--email", "player@example.com"})
+			Expect(err).To(MatchError(internal.ErrMissingFlag))
+			Expect(err.Error()).To(ContainSubstring("--password"))
+		})
+
+		It("should return ErrMissingFlag for create without an email", func() {
+			err := app.Run(context.Background(), []string{"user", "create", "--password", "correct horse 42"})
+			Expect(err).To(MatchError(internal.ErrMissingFlag))
+			Expect(err.Error()).To(ContainSubstring("--email"))
+		})
+	})
+
+	When("a flag is malformed", func() {
+		It("should refuse a number of users that is not a number", func() {
+			err := app.Run(context.Background(), []string{"seed", "--users", "many"})
+			Expect(err).To(HaveOccurred())
+			Expect(output.String()).To(ContainSubstring("invalid value"))
+		})
+
+		It("should refuse a flag the command does not know", func() {
+			err := app.Run(context.Background(), []string{"zone", "generate", "--depth", "3"})
+			Expect(err).To(HaveOccurred())
+			Expect(output.String()).To(ContainSubstring("flag provided but not defined"))
+		})
+	})
+
+	When("help is asked for", func() {
+		It("should return flag.ErrHelp and write the flags of the command", func() {
+			err := app.Run(context.Background(), []string{"seed", "-h"})
+			Expect(err).To(MatchError(flag.ErrHelp))
+			Expect(output.String()).To(ContainSubstring("-units-per-character"))
+		})
+	})
+
+	When("a zone is generated with values the generator refuses", func() {
+		It("should return the error of the generator", func() {
+			err := app.Run(context.Background(), []string{"zone", "generate", "--radius", "-1"})
+			Expect(err).To(MatchError(zoneDomain.ErrInvalidTerritoryRadius))
+
+			err = app.Run(context.Background(), []string{"zone", "generate", "--width", "0"})
+			Expect(err).To(MatchError(zoneDomain.ErrInvalidTerritorySize))
+		})
+	})
+
+	When("a negative number of entities is seeded", func() {
+		It("should return ErrInvalidSeedSize", func() {
+			_, err := internal.Seed(context.Background(), nil, internal.SeedOptions{Users: -1})
+			Expect(err).To(MatchError(internal.ErrInvalidSeedSize))
+		})
+	})
+})
@@ -0,0 +1,161 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"shvdg/crazed-conquerer/apps/cli/internal"
+	sessionApplication "shvdg/crazed-conquerer/internal/domains/session/application"
+	sessionDomain "shvdg/crazed-conquerer/internal/domains/session/domain"
+	sessionInfra "shvdg/crazed-conquerer/internal/domains/session/infrastructure"
+	userApplication "shvdg/crazed-conquerer/internal/domains/user/application"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	userInfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	zoneInfra "shvdg/crazed-conquerer/internal/domains/zone/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/outbox"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"shvdg/crazed-conquerer/internal/shared/tokens"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	countUsersQuery  = "SELECT COUNT(*) FROM " + userInfra.TableName
+	countEventsQuery = "SELECT COUNT(*) FROM " + outbox.TableName + " WHERE " + outbox.FieldEventType + " = $1 AND " + outbox.FieldAggregateId + " = $2"
+)
+
+var _ = Describe("CLI Commands", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var output *bytes.Buffer
+	var app *internal.App
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		app = internal.NewApp(output, internal.WithDatabase(suite.Database))
+	})
+
+	countUsers := func() int {
+		count, err := database.QueryOne(ctx, suite.Database, countUsersQuery, nil, database.ScanInt)
+		Expect(err).ToNot(HaveOccurred(), "failed to count users")
+		return count
+	}
+
+	Context("When the database is seeded", func() {
+		It("should store the number of users asked for", func() {
+			before := countUsers()
+
+			err := app.Run(ctx, []string{"seed", "--users", "3", "--characters-per-user", "2", "--units-per-character", "2", "--seed", "42"})
+			Expect(err).ToNot(HaveOccurred(), "failed to seed")
+			Expect(output.String()).To(ContainSubstring("seeding with seed 42"))
+			Expect(output.String()).To(ContainSubstring("seeded 3 users, 6 characters, 12 units and 6 formations"))
+			Expect(countUsers()).To(Equal(before + 3))
+		})
+
+		It("should not store anything when the number of users is negative", func() {
+			before := countUsers()
+
+			err := app.Run(ctx, []string{"seed", "--users", "-1"})
+			Expect(err).To(MatchError(internal.ErrInvalidSeedSize))
+			Expect(countUsers()).To(Equal(before))
+		})
+	})
+
+	Context("When a zone is generated", func() {
+		It("should store the zone generated from the seed", func() {
+			err := app.Run(ctx, []string{"zone", "generate", "--seed", "7", "--radius", "1"})
+			Expect(err).ToNot(HaveOccurred(), "failed to generate zone")
+
+			var zoneId string
+			_, err = fmt.Sscanf(output.String(), "generated zone %s", &zoneId)
+			Expect(err).ToNot(HaveOccurred(), "failed to read the id of the zone from the output")
+
+			zone, err := zoneInfra.NewZoneRepositoryImpl(suite.Database).GetById(ctx, zoneId)
+			Expect(err).ToNot(HaveOccurred(), "failed to get zone")
+			Expect(zone.GetRows()).ToNot(BeEmpty())
+		})
+	})
+
+	Context("When the password of a user is reset", func() {
+		const oldPassword = "correct horse 42"
+		const newPassword = "battery staple 7"
+
+		var user *userDomain.UserEntity
+		var users *userApplication.UserService
+		var sessions *sessionApplication.SessionService
+		var issued *sessionApplication.Tokens
+
+		BeforeAll(func() {
+			users = userApplication.NewUserService(userInfra.NewUserRepositoryImpl(suite.Database), passwords.Default())
+			user, err = users.Register(ctx, "reset.me@example.com", oldPassword, "Reset Me")
+			Expect(err).ToNot(HaveOccurred(), "failed to register user")
+
+			signer, err := tokens.NewSigner([]byte("a-secret-that-is-long-enough-to-sign"))
+			Expect(err).ToNot(HaveOccurred(), "failed to create signer")
+			sessions = sessionApplication.NewSessionService(suite.Database, users, sessionInfra.NewRefreshTokenRepositoryImpl(suite.Database), signer)
+			issued, err = sessions.Login(ctx, user.GetEmail(), oldPassword)
+			Expect(err).ToNot(HaveOccurred(), "failed to log in")
+		})
+
+		It("should refuse a weak password", func() {
+			err := app.Run(ctx, []string{"user", "reset-password", "--email", user.GetEmail(), "--password", "short"})
+			Expect(err).To(MatchError(userDomain.ErrWeakPassword))
+		})
+
+		It("should return ErrNotFound for an unknown email", func() {
+			err := app.Run(ctx, []string{"user", "reset-password", "--email", "unknown@example.com", "--password", newPassword})
+			Expect(err).To(MatchError(database.ErrNotFound))
+		})
+
+		It("should store the new password hashed", func() {
+			err := app.Run(ctx, []string{"user", "reset-password", "--email", user.GetEmail(), "--password", newPassword})
+			Expect(err).ToNot(HaveOccurred(), "failed to reset password")
+			Expect(output.String()).To(ContainSubstring("reset password of user " + user.GetId()))
+
+			stored, err := userInfra.NewUserRepositoryImpl(suite.Database).GetById(ctx, user.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get user")
+			Expect(passwords.IsHashed(stored.GetPassword())).To(BeTrue(), "expected an encoded hash")
+		})
+
+		It("should record a UserPasswordChanged event in the outbox", func() {
+			count, err := database.QueryOne(ctx, suite.Database, countEventsQuery, []any{userDomain.UserPasswordChangedEvent, user.GetId()}, database.ScanInt)
+			Expect(err).ToNot(HaveOccurred(), "failed to count events")
+			Expect(count).To(Equal(1))
+		})
+
+		It("should let the user log in with the new password only", func() {
+			_, err := users.Login(ctx, user.GetEmail(), newPassword)
+			Expect(err).ToNot(HaveOccurred(), "failed to log in with the new password")
+
+			_, err = users.Login(ctx, user.GetEmail(), oldPassword)
+			Expect(err).To(MatchError(userDomain.ErrInvalidCredentials))
+		})
+
+		It("should end the sessions of the user", func() {
+			_, err := sessions.Refresh(ctx, issued.RefreshToken)
+			Expect(err).To(MatchError(sessionDomain.ErrReusedRefreshToken))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+	"shvdg/crazed-conquerer/internal/shared/schemas"
+	"text/tabwriter"
+	"time"
+)
+
+// MigrateCommand returns the command that applies, reverts and lists the migrations of every domain
+func MigrateCommand() *Command {
+	return &Command{
+		Name: "migrate",
+		Subcommands: []*Command{
+			{Name: "up", Usage: "apply every pending migration", Run: migrateUp},
+			{Name: "down", Usage: "revert the latest migrations [--steps N, 0 reverts all]", Run: migrateDown},
+			{Name: "status", Usage: "list the applied and pending migrations", Run: migrateStatus},
+		},
+	}
+}
+
+// newMigrator creates a migrator for the migrations of every domain
+func newMigrator(ctx context.Context, app *App) (*migrations.Migrator, error) {
+	connection, err := app.Database(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return migrations.NewMigrator(connection, migrations.WithDomains(schemas.All()...)), nil
+}
+
+// migrateUp applies every pending migration
+func migrateUp(ctx context.Context, app *App, args []string) error {
+	if err := newFlagSet("migrate up", app.Output).Parse(args); err != nil {
+		return err
+	}
+
+	migrator, err := newMigrator(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	applied, err := migrator.Up(ctx)
+	for _, step := range applied {
+		_, _ = fmt.Fprintf(app.Output, "applied  %s %d %s\n", step.Domain, step.Version, step.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to migrate up: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(app.Output, "%d migrations applied\n", len(applied))
+	return nil
+}
+
+// migrateDown reverts the latest migrations
+func migrateDown(ctx context.Context, app *App, args []string) error {
+	flags := newFlagSet("migrate down", app.Output)
+	steps := flags.Int("steps", 1, "number of migrations to revert, 0 reverts every migration")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	migrator, err := newMigrator(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	reverted, err := migrator.Down(ctx, *steps)
+	for _, step := range reverted {
+		_, _ = fmt.Fprintf(app.Output, "reverted %s %d %s\n", step.Domain, step.Version, step.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to migrate down: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(app.Output, "%d migrations reverted\n", len(reverted))
+	return nil
+}
+
+// migrateStatus lists the applied and pending migrations
+func migrateStatus(ctx context.Context, app *App, args []string) error {
+	if err := newFlagSet("migrate status", app.Output).Parse(args); err != nil {
+		return err
+	}
+
+	migrator, err := newMigrator(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read migration status: %w", err)
+	}
+
+	writer := tabwriter.NewWriter(app.Output, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "DOMAIN\tVERSION\tNAME\tSTATE\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := ""
+		if status.Applied {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(writer, "%s\t%d\t%s\t%s\t%s\n", status.Domain, status.Version, status.Name, stateOf(status), appliedAt)
+	}
+	return writer.Flush()
+}
+
+// stateOf describes the state of the migration in a single word
+func stateOf(status migrations.Status) string {
+	switch {
+	case status.Unknown:
+		return "unknown"
+	case status.Modified:
+		return "modified"
+	case status.Applied:
+		return "applied"
+	default:
+		return "pending"
+	}
+}
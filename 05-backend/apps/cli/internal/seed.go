@@ -1 +1,98 @@
 package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+	"shvdg/crazed-conquerer/internal/shared/fixtures"
+)
+
+// ErrInvalidSeedSize is returned when a number of entities to seed is negative
+var ErrInvalidSeedSize = errors.New("number of entities to seed must not be negative")
+
+// SeedOptions configures the number of entities Seed creates and the faker that generates them
+type SeedOptions struct {
+	Users             int
+	CharactersPerUser int
+	UnitsPerCharacter int
+	Faker             *faker.Faker
+}
+
+// SeedResult counts the entities Seed created
+type SeedResult struct {
+	Users      int
+	Characters int
+	Units      int
+	Formations int
+}
+
+// SeedCommand returns the command that fills the database with random users, characters, units and formations
+func SeedCommand() *Command {
+	return &Command{Name: "seed", Usage: "create random users with characters, units and formations [--users N --characters-per-user M --units-per-character K --seed S]", Run: seed}
+}
+
+// seed creates random users with characters, units and formations
+func seed(ctx context.Context, app *App, args []string) error {
+	flags := newFlagSet("seed", app.Output)
+	options := SeedOptions{}
+	flags.IntVar(&options.Users, "users", 10, "number of users to create")
+	flags.IntVar(&options.CharactersPerUser, "characters-per-user", 1, "number of characters to create for every user")
+	flags.IntVar(&options.UnitsPerCharacter, "units-per-character", 5, "number of units to create for every character")
+	seed := flags.Int64("seed", 0, "seed that determines the generated data, random when omitted")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	options.Faker = faker.NewRandom()
+	if *seed != 0 {
+		options.Faker = faker.New(*seed)
+	}
+	_, _ = fmt.Fprintf(app.Output, "seeding with seed %d\n", options.Faker.GetSeed())
+
+	connection, err := app.Database(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := Seed(ctx, connection, options)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(app.Output, "seeded %d users, %d characters, %d units and %d formations\n", result.Users, result.Characters, result.Units, result.Formations)
+	return nil
+}
+
+// Seed creates random users owning characters that each own units placed in a formation, all within a single transaction.
+// The same faker seed always results in the same entities, the default faker is used when none is given.
+func Seed(ctx context.Context, connection database.Connection, options SeedOptions) (*SeedResult, error) {
+	if options.Users < 0 || options.CharactersPerUser < 0 || options.UnitsPerCharacter < 0 {
+		return nil, ErrInvalidSeedSize
+	}
+	if options.Faker == nil {
+		options.Faker = faker.Default()
+	}
+
+	builder := fixtures.NewGraph().
+		WithFaker(options.Faker).
+		WithCharacters(options.CharactersPerUser).
+		WithUnitsPerCharacter(options.UnitsPerCharacter)
+
+	graphs := make([]*fixtures.Graph, options.Users)
+	for i := range graphs {
+		graph, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		graphs[i] = graph
+	}
+
+	if err := fixtures.Insert(ctx, connection, graphs...); err != nil {
+		return nil, fmt.Errorf("failed to seed: %w", err)
+	}
+
+	characters := options.Users * options.CharactersPerUser
+	return &SeedResult{Users: options.Users, Characters: characters, Units: characters * options.UnitsPerCharacter, Formations: characters}, nil
+}
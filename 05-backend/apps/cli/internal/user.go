@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	sessionapp "shvdg/crazed-conquerer/internal/domains/session/application"
+	sessioninfra "shvdg/crazed-conquerer/internal/domains/session/infrastructure"
+	userapp "shvdg/crazed-conquerer/internal/domains/user/application"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	userinfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/outbox"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+)
+
+// UserCommand returns the command that administers user accounts
+func UserCommand() *Command {
+	return &Command{
+		Name: "user",
+		Subcommands: []*Command{
+			{Name: "create", Usage: "create a user --email E --password P [--display-name N]", Run: createUser},
+			{Name: "reset-password", Usage: "set the password of a user --email E --password P", Run: resetPassword},
+		},
+	}
+}
+
+// createUser creates a user with the given credentials
+func createUser(ctx context.Context, app *App, args []string) error {
+	flags := newFlagSet("user create", app.Output)
+	email := flags.String("email", "", "email address of the user")
+	password := flags.String("password", "", "password of the user")
+	displayName := flags.String("display-name", "", "display name of the user, random when empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(flags, "email", "password"); err != nil {
+		return err
+	}
+
+	if *displayName == "" {
+		*displayName = userDomain.NewUserEntity().WithDefaults().Build().GetDisplayName()
+	}
+
+	connection, err := app.Database(ctx)
+	if err != nil {
+		return err
+	}
+
+	users := userapp.NewUserService(userinfra.NewUserRepositoryImpl(connection), passwords.Default())
+	user, err := users.Register(ctx, *email, *password, *displayName)
+	if err != nil {
+		return fmt.Errorf("failed to create user '%s': %w", *email, err)
+	}
+
+	_, _ = fmt.Fprintf(app.Output, "created user %s (%s)\n", user.GetId(), user.GetEmail())
+	return nil
+}
+
+// resetPassword replaces the password of the user with the given email address and ends all of their sessions
+func resetPassword(ctx context.Context, app *App, args []string) error {
+	flags := newFlagSet("user reset-password", app.Output)
+	email := flags.String("email", "", "email address of the user")
+	password := flags.String("password", "", "new password of the user")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := required(flags, "email", "password"); err != nil {
+		return err
+	}
+
+	connection, err := app.Database(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the event is stored in the outbox within the transaction of the reset, sessions are only revoked so no signer is needed
+	users := userapp.NewUserService(userinfra.NewUserRepositoryImpl(connection), passwords.Default(), outbox.NewWriter(connection))
+	sessions := sessionapp.NewSessionService(connection, users, sessioninfra.NewRefreshTokenRepositoryImpl(connection), nil)
+
+	var user *userDomain.UserEntity
+	err = database.InTransaction(ctx, connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		user, err = users.ResetPassword(ctx, *email, *password)
+		if err != nil {
+			return err
+		}
+		return sessions.RevokeUser(ctx, user.GetId())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset password of user '%s': %w", *email, err)
+	}
+
+	_, _ = fmt.Fprintf(app.Output, "reset password of user %s (%s) and ended their sessions\n", user.GetId(), user.GetEmail())
+	return nil
+}
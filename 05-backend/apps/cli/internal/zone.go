@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	zoneDomain "shvdg/crazed-conquerer/internal/domains/zone/domain"
+	zoneinfra "shvdg/crazed-conquerer/internal/domains/zone/infrastructure"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ZoneCommand returns the command that manages zones
+func ZoneCommand() *Command {
+	return &Command{
+		Name: "zone",
+		Subcommands: []*Command{
+			{Name: "generate", Usage: "generate and store a zone [--seed S --radius R --width W --height H]", Run: generateZone},
+		},
+	}
+}
+
+// generateZone generates a zone from a seed and stores it
+func generateZone(ctx context.Context, app *App, args []string) error {
+	flags := newFlagSet("zone generate", app.Output)
+	seed := flags.Int64("seed", time.Now().UnixNano(), "seed that determines the zone, random when omitted")
+	radius := flags.Int("radius", int(zoneDomain.DefaultTerritoryRadius), "number of territories between the start and the edge of the zone")
+	width := flags.Int("width", int(zoneDomain.DefaultTerritoryWidth), "number of columns between the centers of territories")
+	height := flags.Int("height", int(zoneDomain.DefaultTerritoryHeight), "number of rows between the centers of territories")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	generator, err := zoneDomain.NewGenerator(*seed,
+		zoneDomain.WithTerritoryRadius(int32(*radius)),
+		zoneDomain.WithTerritorySize(int32(*width), int32(*height)),
+	)
+	if err != nil {
+		return err
+	}
+	zone := generator.Generate(uuid.NewString())
+
+	connection, err := app.Database(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := zoneinfra.NewZoneRepositoryImpl(connection).Create(ctx, zone); err != nil {
+		return fmt.Errorf("failed to store zone: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(app.Output, "generated zone %s from seed %d with %d rows\n", zone.GetId(), *seed, len(zone.GetRows()))
+	return nil
+}
@@ -1,35 +1,83 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
+	"shvdg/crazed-conquerer/apps/server/internal"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	combatDomain "shvdg/crazed-conquerer/internal/domains/combat/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/environment"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+	"shvdg/crazed-conquerer/internal/shared/outbox"
+	"shvdg/crazed-conquerer/internal/shared/schemas"
+	"shvdg/crazed-conquerer/internal/shared/tokens"
+	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/labstack/gommon/log"
 )
 
-// TODO: Implement actual API server.
+// shutdownTimeout is how long requests in flight are given to finish once the server is asked to stop
+const shutdownTimeout = 10 * time.Second
 
 // the main is the entry point of the API server.
 func main() {
 	fmt.Println("Starting up API server...")
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	ech := echo.New()
 	ech.Logger.SetOutput(os.Stdout)
 	ech.Logger.SetLevel(log.DEBUG)
+	ech.Binder = handlers.NewBinder()
+	ech.Use(middleware.Recover())
 	ech.Use(configureCORS())
 
+	if _, err := combatDomain.LoadCatalogue(); err != nil {
+		ech.Logger.Fatal("failed to load vocation catalogue: ", err)
+	}
+
+	signer, err := tokens.NewSigner([]byte(environment.EnvStr(environment.KeyJwtSecret)))
+	if err != nil {
+		ech.Logger.Fatal("failed to configure access tokens, set ", environment.KeyJwtSecret, ": ", err)
+	}
+
+	connection, err := database.NewService(environment.EnvStr(environment.KeyDbDriver), dsn(), database.WithConnection(ctx))
+	if err != nil {
+		ech.Logger.Fatal("failed to connect to database: ", err)
+	}
+	defer connection.Disconnect()
+
+	// pending migrations are applied before serving, the advisory lock of the migrator keeps other instances waiting
+	applied, err := migrations.NewMigrator(connection, migrations.WithDomains(schemas.All()...)).Up(ctx)
+	if err != nil {
+		ech.Logger.Fatal("failed to migrate database: ", err)
+	}
+	fmt.Printf("%d migrations applied\n", len(applied))
+
+	internal.RegisterRoutes(ech, internal.NewServices(connection, signer))
+
 	ech.GET("/", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Echo server is running!")
 	})
 
 	ech.GET("/health", func(c echo.Context) error {
+		if err := connection.GetPool().Ping(c.Request().Context()); err != nil {
+			return c.JSON(http.StatusServiceUnavailable, map[string]string{"status": "database unavailable"})
+		}
 		return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 	})
 
-	port := os.Getenv("API_PORT")
+	port := environment.EnvStr(environment.KeyApiPort)
 	if port == "" {
 		port = "8080"
 	}
@@ -37,9 +85,46 @@ func main() {
 	address := ":" + port
 	fmt.Printf("http server started on %s\n", address)
 
-	if err := ech.Start(address); err != nil {
-		ech.Logger.Fatal("failed to start server: ", err)
+	// the relay publishes the events committed to the outbox until the server is asked to stop
+	relayed := make(chan struct{})
+	go func() {
+		defer close(relayed)
+		if err := outbox.NewRelay(connection, events.NewInMemoryEventBus()).Run(ctx); err != nil {
+			ech.Logger.Error("failed to relay outbox: ", err)
+			stop()
+		}
+	}()
+
+	go func() {
+		if err := ech.Start(address); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			ech.Logger.Error("failed to start server: ", err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := ech.Shutdown(shutdownCtx); err != nil {
+		ech.Logger.Error("failed to shut down server: ", err)
+	}
+
+	select {
+	case <-relayed:
+	case <-shutdownCtx.Done():
+		ech.Logger.Error("failed to stop outbox relay: ", shutdownCtx.Err())
+	}
+}
+
+// dsn returns the data source name of the database configured by the environment
+func dsn() string {
+	if dsn := environment.EnvStr(environment.KeyDbDsn); dsn != "" {
+		return dsn
 	}
+	return database.CreateDsn(environment.EnvStr(environment.KeyDbUser), environment.EnvStr(environment.KeyDbPassword),
+		environment.EnvStr(environment.KeyDbName), "localhost", environment.EnvStr(environment.KeyDbPort))
 }
 
 // configureCORS returns a CORS middleware configuration.
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	characterDomain "shvdg/crazed-conquerer/internal/domains/character/domain"
+	formationDomain "shvdg/crazed-conquerer/internal/domains/formation/domain"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	userCharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Error converts the errors of the domain services into HTTP errors, any other error is left to echo as an internal error.
+// Resources that do not belong to the user are reported as not found, so that their existence is not revealed.
+func Error(err error) error {
+	var placement *formationDomain.PlacementError
+
+	switch {
+	case errors.Is(err, database.ErrNotFound),
+		errors.Is(err, userCharacterDomain.ErrCharacterNotOwned),
+		errors.Is(err, unitDomain.ErrUnitNotOwned),
+		errors.Is(err, formationDomain.ErrFormationNotOwned):
+		return echo.NewHTTPError(http.StatusNotFound, "resource not found").SetInternal(err)
+	case errors.Is(err, userDomain.ErrInvalidEmail),
+		errors.Is(err, userDomain.ErrWeakPassword),
+		errors.Is(err, userDomain.ErrInvalidDisplayName),
+		errors.Is(err, characterDomain.ErrInvalidName),
+		errors.Is(err, unitDomain.ErrInvalidName),
+		errors.Is(err, unitDomain.ErrInvalidVocation),
+		errors.Is(err, unitDomain.ErrInvalidFaction):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, userDomain.ErrEmailTaken),
+		errors.Is(err, formationDomain.ErrFormationExists):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, userDomain.ErrInvalidCredentials):
+		return echo.NewHTTPError(http.StatusForbidden, "password is incorrect")
+	case errors.As(err, &placement):
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	default:
+		return err
+	}
+}
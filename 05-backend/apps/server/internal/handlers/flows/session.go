@@ -0,0 +1,132 @@
+package flows
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	"shvdg/crazed-conquerer/internal/domains/session/application"
+	"shvdg/crazed-conquerer/internal/domains/session/domain"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Sessions issues, refreshes and revokes the tokens of a session
+type Sessions interface {
+	Login(ctx context.Context, email, password string) (*application.Tokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*application.Tokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+}
+
+// LoginRequest is the body of a login request
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// RefreshRequest is the body of a refresh or logout request
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// TokenResponse is the body of a successful login or refresh, following the OAuth 2.0 token response
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+	UserId       string `json:"user_id"`
+}
+
+// SessionHandler handles the requests that log users in and out
+type SessionHandler struct {
+	sessions Sessions
+}
+
+// NewSessionHandler instantiates a new SessionHandler instance
+func NewSessionHandler(sessions Sessions) *SessionHandler {
+	return &SessionHandler{sessions: sessions}
+}
+
+// Login exchanges the credentials of a user for the tokens of a new session
+func (h *SessionHandler) Login(c echo.Context) error {
+	var request LoginRequest
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+	if request.Email == "" || request.Password == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "email and password are required")
+	}
+
+	issued, err := h.sessions.Login(c.Request().Context(), request.Email, request.Password)
+	if err != nil {
+		return sessionError(err)
+	}
+	return c.JSON(http.StatusOK, newTokenResponse(issued))
+}
+
+// Refresh exchanges a refresh token for the tokens that continue its session
+func (h *SessionHandler) Refresh(c echo.Context) error {
+	refreshToken, err := bindRefreshToken(c)
+	if err != nil {
+		return err
+	}
+
+	issued, err := h.sessions.Refresh(c.Request().Context(), refreshToken)
+	if err != nil {
+		return sessionError(err)
+	}
+	return c.JSON(http.StatusOK, newTokenResponse(issued))
+}
+
+// Logout revokes the session of a refresh token
+func (h *SessionHandler) Logout(c echo.Context) error {
+	refreshToken, err := bindRefreshToken(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.sessions.Logout(c.Request().Context(), refreshToken); err != nil {
+		return sessionError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// bindRefreshToken returns the refresh token in the body of the request
+func bindRefreshToken(c echo.Context) (string, error) {
+	var request RefreshRequest
+	if err := c.Bind(&request); err != nil {
+		return "", err
+	}
+	if request.RefreshToken == "" {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "refresh_token is required")
+	}
+	return request.RefreshToken, nil
+}
+
+// newTokenResponse converts issued tokens into the body of the response
+func newTokenResponse(issued *application.Tokens) *TokenResponse {
+	return &TokenResponse{
+		AccessToken:  issued.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(issued.AccessTokenExpiresAt).Round(time.Second).Seconds()),
+		RefreshToken: issued.RefreshToken,
+		UserId:       issued.UserId,
+	}
+}
+
+// sessionError converts the errors of the session service into HTTP errors, hiding why credentials or tokens were refused
+func sessionError(err error) error {
+	switch {
+	case errors.Is(err, userDomain.ErrInvalidCredentials):
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid email or password")
+	case errors.Is(err, domain.ErrInvalidRefreshToken),
+		errors.Is(err, domain.ErrExpiredRefreshToken),
+		errors.Is(err, domain.ErrReusedRefreshToken):
+		return echo.NewHTTPError(http.StatusUnauthorized, "refresh token is invalid")
+	default:
+		return handlers.Error(err)
+	}
+}
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The media types messages are encoded in
+const (
+	MIMEProtobuf = "application/x-protobuf"
+	MIMEJson     = echo.MIMEApplicationJSON
+)
+
+// protobufAliases are the other media types clients use to ask for binary protobuf
+var protobufAliases = []string{"application/protobuf", "application/vnd.google.protobuf"}
+
+// sensitiveFields are the fields that are cleared from every message before it is written to a response
+var sensitiveFields = map[protoreflect.FullName]bool{
+	"user.UserEntity.password": true,
+}
+
+// Binder binds protobuf messages from binary protobuf or the proto3 JSON mapping, depending on the Content-Type.
+// Anything other than a protobuf message is bound by the default binder of echo.
+type Binder struct {
+	fallback echo.DefaultBinder
+}
+
+// NewBinder creates a new instance of Binder
+func NewBinder() *Binder {
+	return &Binder{}
+}
+
+// Bind implements echo.Binder.Bind
+func (b *Binder) Bind(i any, c echo.Context) error {
+	message, ok := i.(proto.Message)
+	if !ok {
+		return b.fallback.Bind(i, c)
+	}
+
+	body, err := readBody(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to read request body").SetInternal(err)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	switch {
+	case isProtobuf(mediaType):
+		err = proto.Unmarshal(body, message)
+	case mediaType == "" || mediaType == MIMEJson:
+		err = protojson.Unmarshal(body, message)
+	default:
+		return echo.ErrUnsupportedMediaType
+	}
+
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "request body is malformed").SetInternal(err)
+	}
+	return nil
+}
+
+// Respond writes the message as the body of the response in the media type the client accepts best, binary
+// protobuf or the proto3 JSON mapping, without its sensitive fields. JSON is written when the client has no preference.
+func Respond(c echo.Context, status int, message proto.Message) error {
+	mediaType, ok := Negotiate(c.Request().Header.Get(echo.HeaderAccept))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotAcceptable, "only "+MIMEJson+" and "+MIMEProtobuf+" are supported")
+	}
+	c.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+
+	message = Strip(message)
+	if mediaType == MIMEProtobuf {
+		body, err := proto.Marshal(message)
+		if err != nil {
+			return err
+		}
+		return c.Blob(status, MIMEProtobuf, body)
+	}
+
+	body, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return c.JSONBlob(status, body)
+}
+
+// Negotiate returns the media type to respond with for the Accept header, or false when none of them is accepted.
+// Media types are weighed by their quality, ties are settled by their order in the header.
+func Negotiate(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return MIMEJson, true
+	}
+
+	ranges := parseAccept(accept)
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+
+	for _, candidate := range ranges {
+		if candidate.quality <= 0 {
+			continue
+		}
+
+		switch {
+		case isProtobuf(candidate.mediaType):
+			return MIMEProtobuf, true
+		case candidate.mediaType == MIMEJson:
+			return MIMEJson, true
+		case candidate.mediaType == "application/*" || candidate.mediaType == "*/*":
+			if !refused(ranges, MIMEJson) {
+				return MIMEJson, true
+			}
+			if !refused(ranges, MIMEProtobuf) {
+				return MIMEProtobuf, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Strip returns a copy of the message with its sensitive fields cleared, including those of nested messages.
+// The message itself is returned when it carries no sensitive fields.
+func Strip(message proto.Message) proto.Message {
+	if !hasSensitive(message.ProtoReflect()) {
+		return message
+	}
+
+	stripped := proto.Clone(message)
+	clearSensitive(stripped.ProtoReflect())
+	return stripped
+}
+
+// hasSensitive returns whether a sensitive field of the message or any of its nested messages is set
+func hasSensitive(message protoreflect.Message) bool {
+	found := false
+	walk(message, func(field protoreflect.FieldDescriptor, _ protoreflect.Message) {
+		found = true
+	})
+	return found
+}
+
+// clearSensitive clears the sensitive fields of the message and its nested messages
+func clearSensitive(message protoreflect.Message) {
+	walk(message, func(field protoreflect.FieldDescriptor, owner protoreflect.Message) {
+		owner.Clear(field)
+	})
+}
+
+// walk calls the function for every sensitive field that is set within the message and its nested messages
+func walk(message protoreflect.Message, function func(field protoreflect.FieldDescriptor, owner protoreflect.Message)) {
+	var sensitive []protoreflect.FieldDescriptor
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case sensitiveFields[field.FullName()]:
+			sensitive = append(sensitive, field)
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				walk(list.Get(i).Message(), function)
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, entry protoreflect.Value) bool {
+				walk(entry.Message(), function)
+				return true
+			})
+		case field.Message() != nil && !field.IsList() && !field.IsMap():
+			walk(value.Message(), function)
+		}
+		return true
+	})
+
+	for _, field := range sensitive {
+		function(field, message)
+	}
+}
+
+// mediaRange is a media type of an Accept header with its quality
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+// parseAccept splits the Accept header into its media ranges, media types that cannot be parsed are left out
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+	}
+	return ranges
+}
+
+// refused returns whether the client explicitly refuses the media type with a quality of zero
+func refused(ranges []mediaRange, mediaType string) bool {
+	for _, candidate := range ranges {
+		if candidate.quality > 0 {
+			continue
+		}
+		if candidate.mediaType == mediaType || (mediaType == MIMEProtobuf && isProtobuf(candidate.mediaType)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isProtobuf returns whether the media type stands for binary protobuf
+func isProtobuf(mediaType string) bool {
+	if mediaType == MIMEProtobuf {
+		return true
+	}
+	for _, alias := range protobufAliases {
+		if mediaType == alias {
+			return true
+		}
+	}
+	return false
+}
+
+// readBody reads the body of the request, which may be read only once
+func readBody(c echo.Context) ([]byte, error) {
+	if c.Request().Body == nil {
+		return nil, nil
+	}
+	return io.ReadAll(c.Request().Body)
+}
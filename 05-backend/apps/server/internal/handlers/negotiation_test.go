@@ -0,0 +1,143 @@
+package handlers_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Negotiation", func() {
+	newContext := func(method, contentType, accept string, body []byte) (echo.Context, *httptest.ResponseRecorder) {
+		request := httptest.NewRequest(method, "/", bytes.NewReader(body))
+		if contentType != "" {
+			request.Header.Set(echo.HeaderContentType, contentType)
+		}
+		if accept != "" {
+			request.Header.Set(echo.HeaderAccept, accept)
+		}
+		recorder := httptest.NewRecorder()
+		return echo.New().NewContext(request, recorder), recorder
+	}
+
+	DescribeTable("choosing the media type of the response",
+		func(accept, expected string, acceptable bool) {
+			mediaType, ok := handlers.Negotiate(accept)
+			Expect(ok).To(Equal(acceptable))
+			Expect(mediaType).To(Equal(expected))
+		},
+		Entry("without preference", "", handlers.MIMEJson, true),
+		Entry("for anything", "*/*", handlers.MIMEJson, true),
+		Entry("for JSON", "application/json", handlers.MIMEJson, true),
+		Entry("for protobuf", "application/x-protobuf", handlers.MIMEProtobuf, true),
+		Entry("for a protobuf alias", "application/protobuf", handlers.MIMEProtobuf, true),
+		Entry("for protobuf over JSON by quality", "application/json;q=0.5, application/x-protobuf", handlers.MIMEProtobuf, true),
+		Entry("for JSON over protobuf by order", "application/json, application/x-protobuf", handlers.MIMEJson, true),
+		Entry("for anything but JSON", "*/*, application/json;q=0", handlers.MIMEProtobuf, true),
+		Entry("for an unsupported type", "text/html", "", false),
+	)
+
+	Describe("stripping sensitive fields", func() {
+		It("should clear the password of a user without changing the original", func() {
+			user := userDomain.NewUserEntity().WithDefaults().Build()
+
+			stripped := handlers.Strip(user).(*userDomain.UserEntity)
+			Expect(stripped.GetPassword()).To(BeEmpty())
+			Expect(stripped.GetEmail()).To(Equal(user.GetEmail()))
+			Expect(user.GetPassword()).ToNot(BeEmpty())
+		})
+
+		It("should leave messages without sensitive fields untouched", func() {
+			list := &unitDomain.UnitList{Units: []*unitDomain.Unit{{Id: "unit-id"}}}
+			Expect(handlers.Strip(list)).To(BeIdenticalTo(list))
+		})
+	})
+
+	Describe("responding", func() {
+		var user *userDomain.UserEntity
+
+		BeforeEach(func() {
+			user = userDomain.NewUserEntity().WithDefaults().Build()
+		})
+
+		It("should write JSON without the password", func() {
+			c, recorder := newContext(http.MethodGet, "", "application/json", nil)
+			Expect(handlers.Respond(c, http.StatusOK, user)).To(Succeed())
+
+			Expect(recorder.Header().Get(echo.HeaderContentType)).To(HavePrefix(handlers.MIMEJson))
+			Expect(recorder.Body.String()).To(ContainSubstring(user.GetEmail()))
+			Expect(recorder.Body.String()).ToNot(ContainSubstring(user.GetPassword()))
+		})
+
+		It("should write protobuf without the password", func() {
+			c, recorder := newContext(http.MethodGet, "", handlers.MIMEProtobuf, nil)
+			Expect(handlers.Respond(c, http.StatusOK, user)).To(Succeed())
+			Expect(recorder.Header().Get(echo.HeaderContentType)).To(Equal(handlers.MIMEProtobuf))
+
+			var decoded userDomain.UserEntity
+			Expect(proto.Unmarshal(recorder.Body.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded.GetEmail()).To(Equal(user.GetEmail()))
+			Expect(decoded.GetPassword()).To(BeEmpty())
+		})
+
+		It("should refuse unsupported media types", func() {
+			c, _ := newContext(http.MethodGet, "", "text/html", nil)
+			err := handlers.Respond(c, http.StatusOK, user)
+
+			var httpError *echo.HTTPError
+			Expect(err).To(BeAssignableToTypeOf(httpError))
+			Expect(err.(*echo.HTTPError).Code).To(Equal(http.StatusNotAcceptable))
+		})
+	})
+
+	Describe("binding", func() {
+		var user *userDomain.UserEntity
+
+		BeforeEach(func() {
+			user = userDomain.NewUserEntity().WithDefaults().Build()
+		})
+
+		It("should bind JSON bodies", func() {
+			body, err := protojson.Marshal(user)
+			Expect(err).ToNot(HaveOccurred())
+
+			c, _ := newContext(http.MethodPost, handlers.MIMEJson, "", body)
+			var bound userDomain.UserEntity
+			Expect(handlers.NewBinder().Bind(&bound, c)).To(Succeed())
+			Expect(bound.GetEmail()).To(Equal(user.GetEmail()))
+		})
+
+		It("should bind protobuf bodies", func() {
+			body, err := proto.Marshal(user)
+			Expect(err).ToNot(HaveOccurred())
+
+			c, _ := newContext(http.MethodPost, handlers.MIMEProtobuf, "", body)
+			var bound userDomain.UserEntity
+			Expect(handlers.NewBinder().Bind(&bound, c)).To(Succeed())
+			Expect(bound.GetPassword()).To(Equal(user.GetPassword()))
+		})
+
+		It("should refuse malformed bodies", func() {
+			c, _ := newContext(http.MethodPost, handlers.MIMEJson, "", []byte("{"))
+			var bound userDomain.UserEntity
+			Expect(handlers.NewBinder().Bind(&bound, c)).ToNot(Succeed())
+		})
+
+		It("should bind other values with the default binder", func() {
+			c, _ := newContext(http.MethodPost, handlers.MIMEJson, "", []byte(`{"password":"secret"}`))
+			var bound struct {
+				Password string `json:"password"`
+			}
+			Expect(handlers.NewBinder().Bind(&bound, c)).To(Succeed())
+			Expect(bound.Password).To(Equal("secret"))
+		})
+	})
+})
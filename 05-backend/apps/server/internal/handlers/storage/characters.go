@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	"shvdg/crazed-conquerer/apps/server/internal/middleware"
+	"shvdg/crazed-conquerer/internal/domains/character/domain"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Characters retrieves and creates the characters of users
+type Characters interface {
+	GetCharacters(ctx context.Context, userId string) ([]*domain.CharacterEntity, error)
+	GetCharacter(ctx context.Context, userId, characterId string) (*domain.CharacterEntity, error)
+	CreateCharacter(ctx context.Context, userId, name string) (*domain.CharacterEntity, error)
+}
+
+// CharacterHandler handles the requests on the characters of the authenticated user
+type CharacterHandler struct {
+	characters Characters
+}
+
+// NewCharacterHandler instantiates a new CharacterHandler instance
+func NewCharacterHandler(characters Characters) *CharacterHandler {
+	return &CharacterHandler{characters: characters}
+}
+
+// List returns the characters of the authenticated user
+func (h *CharacterHandler) List(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	characters, err := h.characters.GetCharacters(c.Request().Context(), userId)
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusOK, &domain.CharacterList{Characters: characters})
+}
+
+// Get returns a character of the authenticated user
+func (h *CharacterHandler) Get(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	character, err := h.characters.GetCharacter(c.Request().Context(), userId, c.Param(ParamCharacterId))
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusOK, character)
+}
+
+// Create creates a character for the authenticated user with the name in the body
+func (h *CharacterHandler) Create(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	var request domain.CharacterEntity
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+
+	character, err := h.characters.CreateCharacter(c.Request().Context(), userId, request.GetName())
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusCreated, character)
+}
@@ -0,0 +1,69 @@
+package storage_test
+
+import (
+	"context"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers/storage"
+	"shvdg/crazed-conquerer/internal/domains/character/domain"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fakeCharacters creates characters in memory, refusing the names the domain refuses
+type fakeCharacters struct {
+	userId string
+}
+
+func (f *fakeCharacters) GetCharacters(context.Context, string) ([]*domain.CharacterEntity, error) {
+	return nil, nil
+}
+
+func (f *fakeCharacters) GetCharacter(context.Context, string, string) (*domain.CharacterEntity, error) {
+	return nil, nil
+}
+
+func (f *fakeCharacters) CreateCharacter(_ context.Context, userId, name string) (*domain.CharacterEntity, error) {
+	f.userId = userId
+	name, err := domain.NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CharacterEntity{Id: "character-id", Name: name}, nil
+}
+
+var _ = Describe("Character Handler", func() {
+	var ech *echo.Echo
+	var characters *fakeCharacters
+
+	BeforeEach(func() {
+		ech = newServer()
+		characters = &fakeCharacters{}
+		ech.POST("/characters", storage.NewCharacterHandler(characters).Create)
+	})
+
+	When("a character is created", func() {
+		It("should create it for the authenticated user and respond with it", func() {
+			recorder := serve(ech, http.MethodPost, "/characters", `{"name": " Conqueror "}`)
+			expectStatus(recorder, http.StatusCreated)
+			Expect(characters.userId).To(Equal(authenticatedUserId))
+
+			var character domain.CharacterEntity
+			Expect(protojson.Unmarshal(recorder.Body.Bytes(), &character)).To(Succeed())
+			Expect(character.GetId()).To(Equal("character-id"))
+			Expect(character.GetName()).To(Equal("Conqueror"))
+		})
+
+		It("should respond with bad request for an invalid name", func() {
+			recorder := serve(ech, http.MethodPost, "/characters", `{"name": "x"}`)
+			expectStatus(recorder, http.StatusBadRequest)
+		})
+
+		It("should respond with bad request for a body that is not a character", func() {
+			recorder := serve(ech, http.MethodPost, "/characters", `{"name": 42}`)
+			expectStatus(recorder, http.StatusBadRequest)
+		})
+	})
+})
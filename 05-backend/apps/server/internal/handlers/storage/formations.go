@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	"shvdg/crazed-conquerer/apps/server/internal/middleware"
+	"shvdg/crazed-conquerer/internal/domains/formation/domain"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Formations retrieves, creates and rearranges the formations of the characters of users
+type Formations interface {
+	GetCharacterFormation(ctx context.Context, userId, characterId string) (*domain.FormationEntity, error)
+	CreateFormation(ctx context.Context, userId, characterId string, rows []*domain.FormationRowEntity) (*domain.FormationEntity, error)
+	PlaceUnits(ctx context.Context, userId, formationId string, rows []*domain.FormationRowEntity) (*domain.FormationEntity, error)
+}
+
+// FormationHandler handles the requests on the formations of the authenticated user
+type FormationHandler struct {
+	formations Formations
+}
+
+// NewFormationHandler instantiates a new FormationHandler instance
+func NewFormationHandler(formations Formations) *FormationHandler {
+	return &FormationHandler{formations: formations}
+}
+
+// GetByCharacter returns the formation of a character of the authenticated user
+func (h *FormationHandler) GetByCharacter(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	formation, err := h.formations.GetCharacterFormation(c.Request().Context(), userId, c.Param(ParamCharacterId))
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusOK, formation)
+}
+
+// CreateForCharacter creates the formation of a character of the authenticated user with the rows in the body
+func (h *FormationHandler) CreateForCharacter(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	var request domain.FormationEntity
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+
+	formation, err := h.formations.CreateFormation(c.Request().Context(), userId, c.Param(ParamCharacterId), request.GetRows())
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusCreated, formation)
+}
+
+// Update replaces the rows of a formation of the authenticated user with the rows in the body
+func (h *FormationHandler) Update(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	var request domain.FormationEntity
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+
+	formation, err := h.formations.PlaceUnits(c.Request().Context(), userId, c.Param(ParamFormationId), request.GetRows())
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusOK, formation)
+}
@@ -0,0 +1,81 @@
+package storage_test
+
+import (
+	"context"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers/storage"
+	"shvdg/crazed-conquerer/internal/domains/formation/domain"
+	userCharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fakeFormations creates one formation in memory for ownedCharacterId, validated against its single unit
+type fakeFormations struct {
+	created bool
+}
+
+func (f *fakeFormations) GetCharacterFormation(context.Context, string, string) (*domain.FormationEntity, error) {
+	return nil, nil
+}
+
+func (f *fakeFormations) PlaceUnits(context.Context, string, string, []*domain.FormationRowEntity) (*domain.FormationEntity, error) {
+	return nil, nil
+}
+
+func (f *fakeFormations) CreateFormation(_ context.Context, userId, characterId string, rows []*domain.FormationRowEntity) (*domain.FormationEntity, error) {
+	if userId != authenticatedUserId || characterId != ownedCharacterId {
+		return nil, userCharacterDomain.ErrCharacterNotOwned
+	}
+	if f.created {
+		return nil, domain.ErrFormationExists
+	}
+
+	formation := &domain.FormationEntity{Id: "formation-id", Rows: rows}
+	if err := domain.NewFormationValidator(domain.WithOwnedUnits("owned-unit")).Validate(formation); err != nil {
+		return nil, err
+	}
+	f.created = true
+	return formation, nil
+}
+
+var _ = Describe("Formation Handler", func() {
+	const path = "/characters/" + ownedCharacterId + "/formation"
+
+	var ech *echo.Echo
+
+	BeforeEach(func() {
+		ech = newServer()
+		ech.POST("/characters/:"+storage.ParamCharacterId+"/formation", storage.NewFormationHandler(&fakeFormations{}).CreateForCharacter)
+	})
+
+	When("a formation is created", func() {
+		It("should respond with the formation placing the units", func() {
+			recorder := serve(ech, http.MethodPost, path, `{"rows": [{"columns": [{"positionX": 0, "positionY": 0, "unitId": "owned-unit"}]}]}`)
+			expectStatus(recorder, http.StatusCreated)
+
+			var formation domain.FormationEntity
+			Expect(protojson.Unmarshal(recorder.Body.Bytes(), &formation)).To(Succeed())
+			Expect(formation.GetId()).To(Equal("formation-id"))
+			Expect(formation.GetRows()).To(HaveLen(1))
+		})
+
+		It("should respond with conflict when the character has a formation already", func() {
+			expectStatus(serve(ech, http.MethodPost, path, `{"rows": []}`), http.StatusCreated)
+			expectStatus(serve(ech, http.MethodPost, path, `{"rows": []}`), http.StatusConflict)
+		})
+
+		It("should respond with unprocessable entity for units of another character", func() {
+			recorder := serve(ech, http.MethodPost, path, `{"rows": [{"columns": [{"positionX": 0, "positionY": 0, "unitId": "foreign-unit"}]}]}`)
+			expectStatus(recorder, http.StatusUnprocessableEntity)
+		})
+
+		It("should respond with not found for a character of another user", func() {
+			recorder := serve(ech, http.MethodPost, "/characters/other-character/formation", `{"rows": []}`)
+			expectStatus(recorder, http.StatusNotFound)
+		})
+	})
+})
@@ -0,0 +1,8 @@
+package storage
+
+// The path parameters of the routes handled in this package
+const (
+	ParamCharacterId = "characterId"
+	ParamUnitId      = "unitId"
+	ParamFormationId = "formationId"
+)
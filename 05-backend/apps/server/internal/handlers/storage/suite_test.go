@@ -0,0 +1,49 @@
+package storage_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestStorage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Storage Handlers Unit Tests")
+}
+
+// authenticatedUserId is the id of the user every request is made by
+const authenticatedUserId = "authenticated-user"
+
+// newServer creates an echo server binding and responding the way the API does, requests are made by authenticatedUserId
+func newServer() *echo.Echo {
+	ech := echo.New()
+	ech.Binder = handlers.NewBinder()
+	ech.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.SetRequest(c.Request().WithContext(contexts.SetUserId(c.Request().Context(), authenticatedUserId)))
+			return next(c)
+		}
+	})
+	return ech
+}
+
+// serve sends a JSON request to the server and returns the recorded response
+func serve(ech *echo.Echo, method, path, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	recorder := httptest.NewRecorder()
+	ech.ServeHTTP(recorder, request)
+	return recorder
+}
+
+// expectStatus fails when the response does not have the status, showing the body of the response
+func expectStatus(recorder *httptest.ResponseRecorder, status int) {
+	ExpectWithOffset(1, recorder.Code).To(Equal(status), "unexpected %s: %s", http.StatusText(recorder.Code), recorder.Body.String())
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	"shvdg/crazed-conquerer/apps/server/internal/middleware"
+	"shvdg/crazed-conquerer/internal/domains/unit/domain"
+	"shvdg/crazed-conquerer/internal/shared/types"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Units retrieves and recruits the units of the characters of users
+type Units interface {
+	GetCharacterUnits(ctx context.Context, userId, characterId string) ([]*domain.UnitEntity, error)
+	GetUnit(ctx context.Context, userId, unitId string) (*domain.UnitEntity, error)
+	RecruitUnit(ctx context.Context, userId, characterId, name string, vocation domain.Vocation, faction types.Faction) (*domain.UnitEntity, error)
+}
+
+// UnitHandler handles the requests on the units of the authenticated user
+type UnitHandler struct {
+	units Units
+}
+
+// NewUnitHandler instantiates a new UnitHandler instance
+func NewUnitHandler(units Units) *UnitHandler {
+	return &UnitHandler{units: units}
+}
+
+// ListByCharacter returns the units of a character of the authenticated user
+func (h *UnitHandler) ListByCharacter(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	units, err := h.units.GetCharacterUnits(c.Request().Context(), userId, c.Param(ParamCharacterId))
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusOK, domain.NewUnitList(units))
+}
+
+// Get returns a unit of the authenticated user
+func (h *UnitHandler) Get(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	unit, err := h.units.GetUnit(c.Request().Context(), userId, c.Param(ParamUnitId))
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusOK, unit)
+}
+
+// Recruit recruits a unit for a character of the authenticated user with the name, vocation and faction in the body
+func (h *UnitHandler) Recruit(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	var request domain.Unit
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+
+	unit, err := h.units.RecruitUnit(c.Request().Context(), userId, c.Param(ParamCharacterId), request.GetName(), request.GetVocation(), request.GetFaction())
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusCreated, unit)
+}
@@ -0,0 +1,77 @@
+package storage_test
+
+import (
+	"context"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers/storage"
+	"shvdg/crazed-conquerer/internal/domains/unit/domain"
+	userCharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+	"shvdg/crazed-conquerer/internal/shared/types"
+
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ownedCharacterId is the only character the authenticated user owns within the fakes
+const ownedCharacterId = "owned-character"
+
+// fakeUnits recruits units in memory for ownedCharacterId, refusing the details the domain refuses
+type fakeUnits struct{}
+
+func (f *fakeUnits) GetCharacterUnits(context.Context, string, string) ([]*domain.UnitEntity, error) {
+	return nil, nil
+}
+
+func (f *fakeUnits) GetUnit(context.Context, string, string) (*domain.UnitEntity, error) {
+	return nil, nil
+}
+
+func (f *fakeUnits) RecruitUnit(_ context.Context, userId, characterId, name string, vocation domain.Vocation, faction types.Faction) (*domain.UnitEntity, error) {
+	if userId != authenticatedUserId || characterId != ownedCharacterId {
+		return nil, userCharacterDomain.ErrCharacterNotOwned
+	}
+	if err := domain.ValidateVocation(vocation); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateFaction(faction); err != nil {
+		return nil, err
+	}
+	return &domain.UnitEntity{Id: "unit-id", Name: name, Vocation: vocation.String(), Faction: faction.String(), Level: domain.RecruitLevel}, nil
+}
+
+var _ = Describe("Unit Handler", func() {
+	var ech *echo.Echo
+
+	BeforeEach(func() {
+		ech = newServer()
+		ech.POST("/characters/:"+storage.ParamCharacterId+"/units", storage.NewUnitHandler(&fakeUnits{}).Recruit)
+	})
+
+	When("a unit is recruited", func() {
+		It("should respond with the recruited unit", func() {
+			recorder := serve(ech, http.MethodPost, "/characters/"+ownedCharacterId+"/units",
+				`{"name": "Sir Lancelot", "vocation": "VOCATION_SWORDSMAN", "faction": "FACTION_HUMAN"}`)
+			expectStatus(recorder, http.StatusCreated)
+
+			var unit domain.UnitEntity
+			Expect(protojson.Unmarshal(recorder.Body.Bytes(), &unit)).To(Succeed())
+			Expect(unit.GetName()).To(Equal("Sir Lancelot"))
+			Expect(unit.GetVocation()).To(Equal(domain.Vocation_VOCATION_SWORDSMAN.String()))
+			Expect(unit.GetLevel()).To(Equal(domain.RecruitLevel))
+		})
+
+		It("should respond with bad request without a vocation", func() {
+			recorder := serve(ech, http.MethodPost, "/characters/"+ownedCharacterId+"/units",
+				`{"name": "Sir Lancelot", "faction": "FACTION_HUMAN"}`)
+			expectStatus(recorder, http.StatusBadRequest)
+		})
+
+		It("should respond with not found for a character of another user", func() {
+			recorder := serve(ech, http.MethodPost, "/characters/other-character/units",
+				`{"name": "Sir Lancelot", "vocation": "VOCATION_SWORDSMAN", "faction": "FACTION_HUMAN"}`)
+			expectStatus(recorder, http.StatusNotFound)
+		})
+	})
+})
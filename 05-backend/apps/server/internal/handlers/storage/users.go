@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"net/http"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers"
+	"shvdg/crazed-conquerer/apps/server/internal/middleware"
+	"shvdg/crazed-conquerer/internal/domains/user/domain"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Users registers users and manages their accounts
+type Users interface {
+	Register(ctx context.Context, email, password, displayName string) (*domain.UserEntity, error)
+	GetUser(ctx context.Context, userId string) (*domain.UserEntity, error)
+	ChangePassword(ctx context.Context, userId, currentPassword, newPassword string) error
+	DeleteAccount(ctx context.Context, userId, password string) error
+}
+
+// ChangePasswordRequest is the body of a request to change the password of the authenticated user
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// DeleteAccountRequest is the body of a request to delete the account of the authenticated user
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
+// UserHandler handles the requests on the accounts of users
+type UserHandler struct {
+	users Users
+}
+
+// NewUserHandler instantiates a new UserHandler instance
+func NewUserHandler(users Users) *UserHandler {
+	return &UserHandler{users: users}
+}
+
+// Register creates a user from the email, password and display name in the body
+func (h *UserHandler) Register(c echo.Context) error {
+	var request domain.UserEntity
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+
+	user, err := h.users.Register(c.Request().Context(), request.GetEmail(), request.GetPassword(), request.GetDisplayName())
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusCreated, user)
+}
+
+// GetMe returns the authenticated user
+func (h *UserHandler) GetMe(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	user, err := h.users.GetUser(c.Request().Context(), userId)
+	if err != nil {
+		return handlers.Error(err)
+	}
+	return handlers.Respond(c, http.StatusOK, user)
+}
+
+// ChangePassword replaces the password of the authenticated user once the current password is verified, which ends their sessions
+func (h *UserHandler) ChangePassword(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	var request ChangePasswordRequest
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+
+	if err := h.users.ChangePassword(c.Request().Context(), userId, request.CurrentPassword, request.NewPassword); err != nil {
+		return handlers.Error(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// DeleteMe deletes the account of the authenticated user once the password is verified, which ends their sessions
+func (h *UserHandler) DeleteMe(c echo.Context) error {
+	userId, _ := middleware.UserId(c)
+
+	var request DeleteAccountRequest
+	if err := c.Bind(&request); err != nil {
+		return err
+	}
+
+	if err := h.users.DeleteAccount(c.Request().Context(), userId, request.Password); err != nil {
+		return handlers.Error(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/tokens"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// UserIdKey is the key under which the id of the authenticated user is stored in the echo context
+const UserIdKey = "user_id"
+
+// bearerPrefix is the scheme that precedes an access token in the Authorization header
+const bearerPrefix = "Bearer "
+
+// Authentication returns a middleware that refuses requests without a valid access token in the Authorization header.
+// The id of the authenticated user is put in both the echo context and the context of the request.
+func Authentication(signer *tokens.Signer) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return echo.NewHTTPError(http.StatusUnauthorized, "missing bearer token")
+			}
+
+			claims, err := signer.Verify(strings.TrimSpace(header[len(bearerPrefix):]))
+			if errors.Is(err, tokens.ErrExpiredToken) {
+				return unauthorized(c, "access token has expired")
+			}
+			if err != nil {
+				return unauthorized(c, "access token is invalid")
+			}
+
+			c.Set(UserIdKey, claims.Subject)
+			c.SetRequest(c.Request().WithContext(contexts.SetUserId(c.Request().Context(), claims.Subject)))
+			return next(c)
+		}
+	}
+}
+
+// UserId returns the id of the user authenticated by the Authentication middleware
+func UserId(c echo.Context) (string, bool) {
+	return contexts.GetUserId(c.Request().Context())
+}
+
+// unauthorized refuses the request, telling the client that the bearer token it sent is not accepted
+func unauthorized(c echo.Context, message string) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+	return echo.NewHTTPError(http.StatusUnauthorized, message)
+}
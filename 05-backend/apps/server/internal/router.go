@@ -1 +1,96 @@
 package internal
+
+import (
+	"shvdg/crazed-conquerer/apps/server/internal/handlers/flows"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers/storage"
+	"shvdg/crazed-conquerer/apps/server/internal/middleware"
+	characterFormationInfrastructure "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
+	characterUnitInfrastructure "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
+	characterApplication "shvdg/crazed-conquerer/internal/domains/character/application"
+	characterInfrastructure "shvdg/crazed-conquerer/internal/domains/character/infrastructure"
+	formationApplication "shvdg/crazed-conquerer/internal/domains/formation/application"
+	formationInfrastructure "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
+	sessionApplication "shvdg/crazed-conquerer/internal/domains/session/application"
+	sessionInfrastructure "shvdg/crazed-conquerer/internal/domains/session/infrastructure"
+	unitApplication "shvdg/crazed-conquerer/internal/domains/unit/application"
+	unitInfrastructure "shvdg/crazed-conquerer/internal/domains/unit/infrastructure"
+	userCharacterApplication "shvdg/crazed-conquerer/internal/domains/user-character/application"
+	userCharacterInfrastructure "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
+	userApplication "shvdg/crazed-conquerer/internal/domains/user/application"
+	userInfrastructure "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/outbox"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+	"shvdg/crazed-conquerer/internal/shared/tokens"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Services are what the handlers of the API depend on
+type Services struct {
+	Sessions   flows.Sessions
+	Users      storage.Users
+	Characters storage.Characters
+	Units      storage.Units
+	Formations storage.Formations
+	Signer     *tokens.Signer
+}
+
+// NewServices creates the domain services of the API on top of the database connection.
+// The events raised are stored in the outbox, so the mutations of the services run in transactions.
+func NewServices(connection database.Connection, signer *tokens.Signer) Services {
+	recorder := outbox.NewWriter(connection)
+
+	users := userApplication.NewUserService(userInfrastructure.NewUserRepositoryImpl(connection, recorder), passwords.Default(), recorder)
+	owners := userCharacterApplication.NewUserCharacterService(userCharacterInfrastructure.NewUserCharacterRepositoryImpl(connection))
+
+	characters := characterApplication.NewCharacterService(characterInfrastructure.NewCharacterRepositoryImpl(connection, recorder), owners)
+	units := unitApplication.NewUnitService(unitInfrastructure.NewUnitRepositoryImpl(connection, recorder),
+		characterUnitInfrastructure.NewCharacterUnitRepositoryImpl(connection, recorder), owners)
+	formations := formationApplication.NewFormationService(formationInfrastructure.NewFormationRepositoryImpl(connection, recorder),
+		characterFormationInfrastructure.NewCharacterFormationRepositoryImpl(connection), owners)
+	sessions := sessionApplication.NewSessionService(connection, users, sessionInfrastructure.NewRefreshTokenRepositoryImpl(connection), signer)
+
+	return Services{
+		Sessions:   transactionalSessions{Sessions: sessions, connection: connection},
+		Users:      transactionalUsers{Users: users, sessions: sessions, connection: connection},
+		Characters: transactionalCharacters{Characters: characters, connection: connection},
+		Units:      transactionalUnits{Units: units, connection: connection},
+		Formations: transactionalFormations{Formations: formations, connection: connection},
+		Signer:     signer,
+	}
+}
+
+// RegisterRoutes registers the routes of the API, versioned under /api/v1
+func RegisterRoutes(ech *echo.Echo, services Services) {
+	sessions := flows.NewSessionHandler(services.Sessions)
+	users := storage.NewUserHandler(services.Users)
+	characters := storage.NewCharacterHandler(services.Characters)
+	units := storage.NewUnitHandler(services.Units)
+	formations := storage.NewFormationHandler(services.Formations)
+
+	v1 := ech.Group("/api/v1")
+
+	v1.POST("/auth/login", sessions.Login)
+	v1.POST("/auth/refresh", sessions.Refresh)
+	v1.POST("/auth/logout", sessions.Logout)
+	v1.POST("/users", users.Register)
+
+	authenticated := v1.Group("", middleware.Authentication(services.Signer))
+
+	authenticated.GET("/users/me", users.GetMe)
+	authenticated.PUT("/users/me/password", users.ChangePassword)
+	authenticated.DELETE("/users/me", users.DeleteMe)
+
+	authenticated.GET("/characters", characters.List)
+	authenticated.POST("/characters", characters.Create)
+	authenticated.GET("/characters/:"+storage.ParamCharacterId, characters.Get)
+	authenticated.GET("/characters/:"+storage.ParamCharacterId+"/units", units.ListByCharacter)
+	authenticated.POST("/characters/:"+storage.ParamCharacterId+"/units", units.Recruit)
+	authenticated.GET("/characters/:"+storage.ParamCharacterId+"/formation", formations.GetByCharacter)
+	authenticated.POST("/characters/:"+storage.ParamCharacterId+"/formation", formations.CreateForCharacter)
+
+	authenticated.GET("/units/:"+storage.ParamUnitId, units.Get)
+
+	authenticated.PUT("/formations/:"+storage.ParamFormationId, formations.Update)
+}
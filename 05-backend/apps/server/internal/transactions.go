@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers/flows"
+	"shvdg/crazed-conquerer/apps/server/internal/handlers/storage"
+	characterDomain "shvdg/crazed-conquerer/internal/domains/character/domain"
+	formationDomain "shvdg/crazed-conquerer/internal/domains/formation/domain"
+	sessionApplication "shvdg/crazed-conquerer/internal/domains/session/application"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/types"
+)
+
+// transactional runs the function in a transaction, so the events it raises are stored in the outbox along with its changes
+func transactional[T any](ctx context.Context, connection database.Connection, function func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := database.InTransaction(ctx, connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		var err error
+		result, err = function(ctx)
+		return err
+	})
+	return result, err
+}
+
+// transactionalSessions runs the login and logout in transactions, a refresh runs its own so that
+// the session of a reused refresh token stays revoked when the refresh is refused
+type transactionalSessions struct {
+	flows.Sessions
+	connection database.Connection
+}
+
+// Login runs the login in a transaction
+func (t transactionalSessions) Login(ctx context.Context, email, password string) (*sessionApplication.Tokens, error) {
+	return transactional(ctx, t.connection, func(ctx context.Context) (*sessionApplication.Tokens, error) {
+		return t.Sessions.Login(ctx, email, password)
+	})
+}
+
+// Logout runs the logout in a transaction
+func (t transactionalSessions) Logout(ctx context.Context, refreshToken string) error {
+	return database.InTransaction(ctx, t.connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		return t.Sessions.Logout(ctx, refreshToken)
+	})
+}
+
+// sessionRevoker ends the sessions of a user
+type sessionRevoker interface {
+	RevokeUser(ctx context.Context, userId string) error
+}
+
+// transactionalUsers runs the mutations of the users in transactions
+type transactionalUsers struct {
+	storage.Users
+	sessions   sessionRevoker
+	connection database.Connection
+}
+
+// Register runs the registration in a transaction
+func (t transactionalUsers) Register(ctx context.Context, email, password, displayName string) (*userDomain.UserEntity, error) {
+	return transactional(ctx, t.connection, func(ctx context.Context) (*userDomain.UserEntity, error) {
+		return t.Users.Register(ctx, email, password, displayName)
+	})
+}
+
+// ChangePassword runs the change of the password in a transaction that also ends all sessions of the user
+func (t transactionalUsers) ChangePassword(ctx context.Context, userId, currentPassword, newPassword string) error {
+	return database.InTransaction(ctx, t.connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		if err := t.Users.ChangePassword(ctx, userId, currentPassword, newPassword); err != nil {
+			return err
+		}
+		return t.sessions.RevokeUser(ctx, userId)
+	})
+}
+
+// DeleteAccount runs the deletion of the account in a transaction
+func (t transactionalUsers) DeleteAccount(ctx context.Context, userId, password string) error {
+	return database.InTransaction(ctx, t.connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		return t.Users.DeleteAccount(ctx, userId, password)
+	})
+}
+
+// transactionalCharacters runs the mutations of the characters in transactions
+type transactionalCharacters struct {
+	storage.Characters
+	connection database.Connection
+}
+
+// CreateCharacter runs the creation of the character in a transaction
+func (t transactionalCharacters) CreateCharacter(ctx context.Context, userId, name string) (*characterDomain.CharacterEntity, error) {
+	return transactional(ctx, t.connection, func(ctx context.Context) (*characterDomain.CharacterEntity, error) {
+		return t.Characters.CreateCharacter(ctx, userId, name)
+	})
+}
+
+// transactionalUnits runs the mutations of the units in transactions
+type transactionalUnits struct {
+	storage.Units
+	connection database.Connection
+}
+
+// RecruitUnit runs the recruitment of the unit in a transaction
+func (t transactionalUnits) RecruitUnit(ctx context.Context, userId, characterId, name string, vocation unitDomain.Vocation, faction types.Faction) (*unitDomain.UnitEntity, error) {
+	return transactional(ctx, t.connection, func(ctx context.Context) (*unitDomain.UnitEntity, error) {
+		return t.Units.RecruitUnit(ctx, userId, characterId, name, vocation, faction)
+	})
+}
+
+// transactionalFormations runs the mutations of the formations in transactions
+type transactionalFormations struct {
+	storage.Formations
+	connection database.Connection
+}
+
+// CreateFormation runs the creation of the formation in a transaction
+func (t transactionalFormations) CreateFormation(ctx context.Context, userId, characterId string, rows []*formationDomain.FormationRowEntity) (*formationDomain.FormationEntity, error) {
+	return transactional(ctx, t.connection, func(ctx context.Context) (*formationDomain.FormationEntity, error) {
+		return t.Formations.CreateFormation(ctx, userId, characterId, rows)
+	})
+}
+
+// PlaceUnits runs the placement of the units in a transaction
+func (t transactionalFormations) PlaceUnits(ctx context.Context, userId, formationId string, rows []*formationDomain.FormationRowEntity) (*formationDomain.FormationEntity, error) {
+	return transactional(ctx, t.connection, func(ctx context.Context) (*formationDomain.FormationEntity, error) {
+		return t.Formations.PlaceUnits(ctx, userId, formationId, rows)
+	})
+}
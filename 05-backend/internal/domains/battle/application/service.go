@@ -1,16 +1,114 @@
 package application
 
-import "shvdg/crazed-conquerer/internal/shared/types"
+import (
+	"context"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/battle/domain"
+	combatDomain "shvdg/crazed-conquerer/internal/domains/combat/domain"
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"shvdg/crazed-conquerer/internal/shared/types"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // BattleService handles battle-related operations
 type BattleService struct {
+	battles  domain.BattleRepository
+	recorder events.Recorder
 }
 
-// NewBattleService instantiates a new BattleService instance
-func NewBattleService() *BattleService {
-	return &BattleService{}
+// NewBattleService instantiates a new BattleService instance, the recorders receive the events it raises
+func NewBattleService(battles domain.BattleRepository, recorders ...events.Recorder) *BattleService {
+	return &BattleService{battles: battles, recorder: events.Recorders(recorders)}
 }
 
 // StartBattle starts a new battle
 func (s *BattleService) StartBattle(characterId string, formationId string, zoneId string, coordinates *types.Coordinates) {
 }
+
+// Fight runs the battle between the combatants until it has ended and stores its log, the combatants are left untouched
+func (s *BattleService) Fight(ctx context.Context, seed int64, combatants []*combatDomain.Combatant, options ...combatDomain.BattleOpt) (*domain.BattleEntity, error) {
+	initial, err := combatDomain.MarshalCombatants(combatants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot combatants: %w", err)
+	}
+
+	battle, err := combatDomain.Replay(seed, combatants, -1, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fight battle: %w", err)
+	}
+
+	outcome := battle.GetOutcome()
+	now := converters.TimeToTimestamp(time.Now())
+	entity := &domain.BattleEntity{
+		Id:         uuid.NewString(),
+		Seed:       seed,
+		Winner:     outcome.Winner.String(),
+		Ticks:      outcome.Ticks,
+		Combatants: initial,
+		Actions:    toActionEntities(battle.GetLog()),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := s.battles.Create(ctx, entity); err != nil {
+		return nil, fmt.Errorf("failed to create battle: %w", err)
+	}
+
+	if err := s.recorder.Record(ctx, battleEvents(entity, combatants, battle.GetLog())...); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// Replay rebuilds the stored battle from its seed and initial combatants, advanced up to the given tick
+func (s *BattleService) Replay(ctx context.Context, battleId string, tick int32, options ...combatDomain.BattleOpt) (*combatDomain.Battle, error) {
+	entity, err := s.battles.GetById(ctx, battleId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get battle '%s': %w", battleId, err)
+	}
+
+	combatants, err := combatDomain.UnmarshalCombatants(entity.GetCombatants())
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore combatants of battle '%s': %w", battleId, err)
+	}
+
+	return combatDomain.Replay(entity.GetSeed(), combatants, tick, options...)
+}
+
+// battleEvents returns the events of the fought battle: its start, the deaths in the order they happened and its end
+func battleEvents(entity *domain.BattleEntity, combatants []*combatDomain.Combatant, log []combatDomain.Action) []events.Event {
+	unitIds := make([]string, len(combatants))
+	for i, combatant := range combatants {
+		unitIds[i] = combatant.UnitId
+	}
+
+	raised := []events.Event{domain.NewBattleStarted(entity, unitIds)}
+	for _, action := range log {
+		if action.Type == combatDomain.ActionDeath {
+			raised = append(raised, combatDomain.NewCombatantDied(entity.GetId(), action))
+		}
+	}
+	return append(raised, domain.NewBattleEnded(entity))
+}
+
+// toActionEntities converts the log of a battle into its persisted form
+func toActionEntities(log []combatDomain.Action) []*domain.BattleActionEntity {
+	actions := make([]*domain.BattleActionEntity, len(log))
+	for i, action := range log {
+		actions[i] = &domain.BattleActionEntity{
+			Tick:      action.Tick,
+			Type:      string(action.Type),
+			ActorId:   action.ActorId,
+			TargetId:  action.TargetId,
+			Name:      action.Name,
+			Amount:    action.Amount,
+			PositionX: action.X,
+			PositionY: action.Y,
+		}
+	}
+	return actions
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fromActionsJsonToActionsEntity converts a JSON array of battle actions to a slice of BattleActionEntity's.
+func fromActionsJsonToActionsEntity(actionsJson []byte) ([]*BattleActionEntity, error) {
+	var actionsRaw []json.RawMessage
+	if err := json.Unmarshal(actionsJson, &actionsRaw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal battle actions: %w", err)
+	}
+
+	actions := make([]*BattleActionEntity, len(actionsRaw))
+	for i, actionJson := range actionsRaw {
+		action := &BattleActionEntity{}
+		if err := protojson.Unmarshal(actionJson, action); err != nil {
+			return actions, fmt.Errorf("failed to unmarshal battle action: %w", err)
+		}
+		actions[i] = action
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+	"time"
+)
+
+// BattleEntityBuilder helps build and configure a BattleEntity object.
+type BattleEntityBuilder struct {
+	battleEntity *BattleEntity
+	faker        *faker.Faker
+}
+
+// NewBattleEntity initializes a new BattleEntityBuilder with empty values.
+func NewBattleEntity() *BattleEntityBuilder {
+	return &BattleEntityBuilder{battleEntity: &BattleEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *BattleEntityBuilder) WithFaker(faker *faker.Faker) *BattleEntityBuilder {
+	b.faker = faker
+	return b
+}
+
+// WithId sets the id of the battle entity.
+func (b *BattleEntityBuilder) WithId(id string) *BattleEntityBuilder {
+	b.battleEntity.Id = id
+	return b
+}
+
+// WithRandomId sets a random id for the battle entity.
+func (b *BattleEntityBuilder) WithRandomId() *BattleEntityBuilder {
+	b.battleEntity.Id = b.faker.UUID()
+	return b
+}
+
+// WithSeed sets the seed the battle was fought with.
+func (b *BattleEntityBuilder) WithSeed(seed int64) *BattleEntityBuilder {
+	b.battleEntity.Seed = seed
+	return b
+}
+
+// WithWinner sets the team that won the battle.
+func (b *BattleEntityBuilder) WithWinner(winner string) *BattleEntityBuilder {
+	b.battleEntity.Winner = winner
+	return b
+}
+
+// WithTicks sets the number of ticks the battle lasted.
+func (b *BattleEntityBuilder) WithTicks(ticks int32) *BattleEntityBuilder {
+	b.battleEntity.Ticks = ticks
+	return b
+}
+
+// WithCombatants sets the serialized combatants as they were before the first tick.
+func (b *BattleEntityBuilder) WithCombatants(combatants []byte) *BattleEntityBuilder {
+	b.battleEntity.Combatants = combatants
+	return b
+}
+
+// WithEmptyCombatants sets an empty combatants array for the battle entity.
+func (b *BattleEntityBuilder) WithEmptyCombatants() *BattleEntityBuilder {
+	b.battleEntity.Combatants = []byte("[]")
+	return b
+}
+
+// WithActions sets the actions of the battle entity.
+func (b *BattleEntityBuilder) WithActions(actions []*BattleActionEntity) *BattleEntityBuilder {
+	b.battleEntity.Actions = actions
+	return b
+}
+
+// WithEmptyActions sets an empty actions array for the battle entity.
+func (b *BattleEntityBuilder) WithEmptyActions() *BattleEntityBuilder {
+	b.battleEntity.Actions = []*BattleActionEntity{}
+	return b
+}
+
+// WithActionsFromJson directly unmarshal BattleActionEntity array from JSON
+func (b *BattleEntityBuilder) WithActionsFromJson(actionsJson []byte) *BattleEntityBuilder {
+	actions, err := fromActionsJsonToActionsEntity(actionsJson)
+	if err != nil {
+		b.WithEmptyActions()
+	} else {
+		b.WithActions(actions)
+	}
+
+	return b
+}
+
+// WithCreatedAt sets the creation time of the battle entity.
+func (b *BattleEntityBuilder) WithCreatedAt(t time.Time) *BattleEntityBuilder {
+	b.battleEntity.CreatedAt = converters.TimeToTimestamp(t)
+	return b
+}
+
+// WithUpdatedAt sets the updated at time of the battle entity.
+func (b *BattleEntityBuilder) WithUpdatedAt(t time.Time) *BattleEntityBuilder {
+	b.battleEntity.UpdatedAt = converters.TimeToTimestamp(t)
+	return b
+}
+
+// WithDefaults populates all fields with random default values.
+func (b *BattleEntityBuilder) WithDefaults() *BattleEntityBuilder {
+	now := time.Now()
+	return b.WithRandomId().
+		WithEmptyCombatants().
+		WithEmptyActions().
+		WithCreatedAt(now).
+		WithUpdatedAt(now)
+}
+
+// Build returns the configured BattleEntity object.
+func (b *BattleEntityBuilder) Build() *BattleEntity {
+	return b.battleEntity
+}
@@ -1 +1,62 @@
 package domain
+
+import (
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/events"
+)
+
+// The types of the events raised by the battle domain
+const (
+	BattleStartedEvent = "battle.started"
+	BattleEndedEvent   = "battle.ended"
+)
+
+// BattleStarted is raised when the combatants of a battle have been set up
+type BattleStarted struct {
+	events.Base
+	BattleId   string
+	Seed       int64
+	Combatants []string
+}
+
+// NewBattleStarted creates the event raised when the battle with the combatants has started
+func NewBattleStarted(battle *BattleEntity, combatants []string) *BattleStarted {
+	return &BattleStarted{Base: events.NewBase(battle.GetId()), BattleId: battle.GetId(), Seed: battle.GetSeed(), Combatants: combatants}
+}
+
+// Type implements events.Event
+func (e *BattleStarted) Type() string { return BattleStartedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *BattleStarted) Data() any {
+	return &BattleStartedPayload{BattleId: e.BattleId, Seed: e.Seed, Combatants: e.Combatants}
+}
+
+// BattleEnded is raised when a battle has been fought and stored
+type BattleEnded struct {
+	events.Base
+	BattleId string
+	Winner   string
+	Ticks    int32
+}
+
+// NewBattleEnded creates the event raised when the battle has ended
+func NewBattleEnded(battle *BattleEntity) *BattleEnded {
+	return &BattleEnded{Base: events.NewBase(battle.GetId()), BattleId: battle.GetId(), Winner: battle.GetWinner(), Ticks: battle.GetTicks()}
+}
+
+// Type implements events.Event
+func (e *BattleEnded) Type() string { return BattleEndedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *BattleEnded) Data() any {
+	return &BattleEndedPayload{BattleId: e.BattleId, Winner: e.Winner, Ticks: e.Ticks}
+}
+
+// RegisterEvents registers the payloads of the events of the domain
+func RegisterEvents(registry *events.Registry) error {
+	return errors.Join(
+		registry.Register(BattleStartedEvent, 1, &BattleStartedPayload{}),
+		registry.Register(BattleEndedEvent, 1, &BattleEndedPayload{}),
+	)
+}
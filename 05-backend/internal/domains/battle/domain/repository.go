@@ -0,0 +1,9 @@
+package domain
+
+import "context"
+
+// BattleRepository representation of a battle repository
+type BattleRepository interface {
+	GetById(ctx context.Context, id string) (*BattleEntity, error)
+	Create(ctx context.Context, entities ...*BattleEntity) error
+}
@@ -0,0 +1,12 @@
+package infrastructure
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+)
+
+// Migrations returns the versioned changes to the battles table
+func Migrations() migrations.Domain {
+	return migrations.NewDomain(TableName, nil,
+		migrations.NewMigration(1, "create battles table", CreateTableQuery, DropTableQuery),
+	)
+}
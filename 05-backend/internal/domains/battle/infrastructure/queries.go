@@ -0,0 +1,33 @@
+package infrastructure
+
+// Names
+const (
+	TableName = "battles"
+
+	FieldId         = "id"
+	FieldSeed       = "seed"
+	FieldWinner     = "winner"
+	FieldTicks      = "ticks"
+	FieldCombatants = "combatants"
+	FieldActions    = "actions"
+	FieldCreatedAt  = "created_at"
+	FieldUpdatedAt  = "updated_at"
+)
+
+// SQL query constants
+const (
+	CreateTableQuery = `
+		CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+			` + FieldId + ` VARCHAR(255) PRIMARY KEY,
+			` + FieldSeed + ` BIGINT NOT NULL,
+			` + FieldWinner + ` VARCHAR(255) NOT NULL,
+			` + FieldTicks + ` INT NOT NULL,
+			` + FieldCombatants + ` JSONB NOT NULL DEFAULT '[]'::jsonb,
+			` + FieldActions + ` JSONB NOT NULL DEFAULT '[]'::jsonb,
+			` + FieldCreatedAt + ` TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			` + FieldUpdatedAt + ` TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);
+	`
+
+	DropTableQuery = `DROP TABLE IF EXISTS ` + TableName + ` CASCADE;`
+)
@@ -0,0 +1,147 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/battle/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/sql"
+)
+
+// BattleRepositoryImpl provides the concrete implementation of the BattleRepository interface
+type BattleRepositoryImpl struct {
+	database.Connection
+}
+
+// NewBattleRepositoryImpl creates a new instance of BattleRepositoryImpl
+func NewBattleRepositoryImpl(connection database.Connection) *BattleRepositoryImpl {
+	return &BattleRepositoryImpl{connection}
+}
+
+// GetById retrieves a battle by its id
+func (s *BattleRepositoryImpl) GetById(ctx context.Context, id string) (*domain.BattleEntity, error) {
+	query, args := sql.NewQuery().
+		Select(FieldId, FieldSeed, FieldWinner, FieldTicks, FieldCombatants, FieldActions, FieldCreatedAt, FieldUpdatedAt).
+		From(TableName).
+		Where(FieldId, id).
+		Build()
+
+	return s.ReadOne(ctx, query, args, ScanBattleEntity)
+}
+
+// Create implements Repository.Create
+func (s *BattleRepositoryImpl) Create(ctx context.Context, entities ...*domain.BattleEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		args, err := toArgs(entity)
+		if err != nil {
+			return err
+		}
+		argSets[i] = args
+	}
+
+	query, batchArgs := sql.NewQuery().
+		InsertInto(TableName).
+		InsertFields(FieldId, FieldSeed, FieldWinner, FieldTicks, FieldCombatants, FieldActions).
+		BatchValues(argSets).
+		BuildBatch()
+
+	return database.Batch(ctx, s.Connection, query, batchArgs)
+}
+
+// Update updates one or more battle entities in the database
+func (s *BattleRepositoryImpl) Update(ctx context.Context, entities ...*domain.BattleEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		args, err := toArgs(entity)
+		if err != nil {
+			return err
+		}
+		argSets[i] = append(args[1:], entity.GetId())
+	}
+
+	query, batchArgs := sql.NewQuery().
+		Update(TableName).
+		BatchSets(argSets, FieldSeed, FieldWinner, FieldTicks, FieldCombatants, FieldActions).
+		Where(FieldId).
+		BuildBatch()
+
+	return database.Batch(ctx, s.Connection, query, batchArgs)
+}
+
+// Upsert upserts one or more battle entities in the database
+func (s *BattleRepositoryImpl) Upsert(ctx context.Context, entities ...*domain.BattleEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		args, err := toArgs(entity)
+		if err != nil {
+			return err
+		}
+		argSets[i] = args
+	}
+
+	query, batchArgs := sql.NewQuery().
+		InsertInto(TableName).
+		InsertFields(FieldId, FieldSeed, FieldWinner, FieldTicks, FieldCombatants, FieldActions).
+		BatchUpsert(argSets, []string{FieldId}, FieldSeed, FieldWinner, FieldTicks, FieldCombatants, FieldActions).
+		BuildBatch()
+
+	return database.Batch(ctx, s.Connection, query, batchArgs)
+}
+
+// Delete removes one or more battle entities from the database
+func (s *BattleRepositoryImpl) Delete(ctx context.Context, entities ...*domain.BattleEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	ids := make([]any, len(entities))
+	for i, entity := range entities {
+		ids[i] = entity.GetId()
+	}
+
+	query, args := sql.NewQuery().
+		DeleteFrom(TableName).
+		WhereIn(FieldId, ids...).
+		Build()
+
+	return database.Execute(ctx, s.Connection, query, args...)
+}
+
+// ReadOne executes a query and returns a single battle entity
+func (s *BattleRepositoryImpl) ReadOne(ctx context.Context, query string, values []any, scan database.ScannerFunc[*domain.BattleEntity]) (*domain.BattleEntity, error) {
+	return database.QueryOne(ctx, s.Connection, query, values, scan)
+}
+
+// ReadMany executes a query and returns multiple battle entities
+func (s *BattleRepositoryImpl) ReadMany(ctx context.Context, query string, values []any, scan database.ScannerFunc[*domain.BattleEntity]) ([]*domain.BattleEntity, error) {
+	return database.QueryMany(ctx, s.Connection, query, values, scan)
+}
+
+// toArgs returns the values of the battle entity in the order of the inserted fields
+func toArgs(entity *domain.BattleEntity) ([]any, error) {
+	actions, err := json.Marshal(entity.GetActions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal battle actions: %w", err)
+	}
+
+	combatants := entity.GetCombatants()
+	if len(combatants) == 0 {
+		combatants = []byte("[]")
+	}
+
+	return []any{entity.GetId(), entity.GetSeed(), entity.GetWinner(), entity.GetTicks(), json.RawMessage(combatants), json.RawMessage(actions)}, nil
+}
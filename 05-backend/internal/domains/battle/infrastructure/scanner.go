@@ -0,0 +1,39 @@
+package infrastructure
+
+import (
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/battle/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ScanBattleEntity scans database row data into a BattleEntity
+func ScanBattleEntity(scanner database.RowScanner) (*domain.BattleEntity, error) {
+	var id, winner string
+	var seed int64
+	var ticks int32
+	var combatantsJson, actionsJson []byte
+	var createdAt, updatedAt pgtype.Timestamp
+
+	if err := scanner.Scan(&id, &seed, &winner, &ticks, &combatantsJson, &actionsJson, &createdAt, &updatedAt); err != nil {
+		return nil, fmt.Errorf("failed to scan battle entity: %w", err)
+	}
+
+	builder := domain.NewBattleEntity().
+		WithId(id).
+		WithSeed(seed).
+		WithWinner(winner).
+		WithTicks(ticks).
+		WithCombatants(combatantsJson).
+		WithActionsFromJson(actionsJson)
+
+	if createdAt.Valid {
+		builder = builder.WithCreatedAt(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		builder = builder.WithUpdatedAt(updatedAt.Time)
+	}
+
+	return builder.Build(), nil
+}
@@ -0,0 +1,162 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/battle/application"
+	"shvdg/crazed-conquerer/internal/domains/battle/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/battle/infrastructure"
+	combatDomain "shvdg/crazed-conquerer/internal/domains/combat/domain"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/sql"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newCombatant creates a combatant of the vocation placed on the side of the team
+func newCombatant(catalogue *combatDomain.Catalogue, unitId, vocation string, team sharedDomain.Team, x, y int32) *combatDomain.Combatant {
+	definition, err := catalogue.GetVocation(vocation)
+	Expect(err).ToNot(HaveOccurred(), "failed to get vocation")
+
+	combatant := &combatDomain.Combatant{UnitId: unitId, Team: team}
+	definition.Equip(combatant, 1)
+	combatant.Place(x, y)
+	return combatant
+}
+
+var _ = Describe("Battle Repository", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var recorder *testing.EventRecorder
+	var battleRepo *infra.BattleRepositoryImpl
+	var battleService *application.BattleService
+	var catalogue *combatDomain.Catalogue
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+		recorder = testing.NewEventRecorder()
+		catalogue, err = combatDomain.LoadCatalogue()
+		Expect(err).ToNot(HaveOccurred(), "failed to load vocation catalogue")
+
+		battleRepo = infra.NewBattleRepositoryImpl(suite.Database)
+		battleService = application.NewBattleService(battleRepo, recorder)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When one battle is created", func() {
+		var battle *domain.BattleEntity
+
+		BeforeAll(func() {
+			battle = domain.NewBattleEntity().WithDefaults().
+				WithSeed(42).
+				WithWinner(sharedDomain.Team_TEAM_PLAYER.String()).
+				WithTicks(12).
+				WithActions([]*domain.BattleActionEntity{
+					{Tick: 1, Type: "move", ActorId: "a", Name: "step", PositionX: 1},
+					{Tick: 2, Type: "attack", ActorId: "a", TargetId: "b", Name: "slash", Amount: 7},
+				}).
+				Build()
+		})
+
+		It("should successfully store the battle in the database", func() {
+			err := battleRepo.Create(ctx, battle)
+			Expect(err).ToNot(HaveOccurred(), "failed to create battle")
+
+			query, args := sql.NewQuery().Count().From(infra.TableName).Where(infra.FieldId, battle.GetId()).Build()
+			count, err := database.QueryOne(ctx, suite.Database, query, args, database.ScanInt)
+
+			Expect(err).ToNot(HaveOccurred(), "failed to count battles")
+			Expect(count).To(Equal(1), "expected 1 battle to be created")
+		})
+
+		It("should return the battle with its actions in order", func() {
+			foundBattle, err := battleRepo.GetById(ctx, battle.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get battle by ID")
+			Expect(foundBattle.GetSeed()).To(Equal(int64(42)))
+			Expect(foundBattle.GetWinner()).To(Equal(sharedDomain.Team_TEAM_PLAYER.String()))
+			Expect(foundBattle.GetTicks()).To(Equal(int32(12)))
+			Expect(foundBattle.GetActions()).To(HaveLen(2))
+			Expect(foundBattle.GetActions()[0].GetType()).To(Equal("move"))
+			Expect(foundBattle.GetActions()[1].GetTargetId()).To(Equal("b"))
+			Expect(foundBattle.GetActions()[1].GetAmount()).To(Equal(int32(7)))
+		})
+	})
+
+	Context("When a battle is fought and replayed", func() {
+		var combatants []*combatDomain.Combatant
+		var battle *domain.BattleEntity
+
+		BeforeAll(func() {
+			combatants = []*combatDomain.Combatant{
+				newCombatant(catalogue, "swordsman", "VOCATION_SWORDSMAN", sharedDomain.Team_TEAM_PLAYER, 4, 1),
+				newCombatant(catalogue, "summoner", "VOCATION_SUMMONER", sharedDomain.Team_TEAM_PLAYER, 1, 2),
+				newCombatant(catalogue, "enemy-swordsman", "VOCATION_SWORDSMAN", sharedDomain.Team_TEAM_OPPONENT, 4, 1),
+				newCombatant(catalogue, "enemy-axeman", "VOCATION_AXEMAN", sharedDomain.Team_TEAM_OPPONENT, 1, 3),
+			}
+
+			battle, err = battleService.Fight(ctx, 7, combatants)
+			Expect(err).ToNot(HaveOccurred(), "failed to fight battle")
+		})
+
+		It("should leave the combatants untouched", func() {
+			Expect(combatants[0].State.Health).To(Equal(combatants[0].State.MaxHealth))
+			Expect(combatants[0].State.Coordinates.X).To(Equal(int32(4)))
+		})
+
+		It("should persist the log of the battle", func() {
+			foundBattle, err := battleRepo.GetById(ctx, battle.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get battle by ID")
+			Expect(foundBattle.GetActions()).To(HaveLen(len(battle.GetActions())))
+			Expect(foundBattle.GetActions()).ToNot(BeEmpty())
+		})
+
+		It("should raise the events of the battle", func() {
+			started := recorder.OfType(domain.BattleStartedEvent)
+			Expect(started).To(HaveLen(1), "expected 1 BattleStarted event")
+			Expect(started[0].(*domain.BattleStarted).Combatants).To(HaveLen(4))
+
+			ended := recorder.OfType(domain.BattleEndedEvent)
+			Expect(ended).To(HaveLen(1), "expected 1 BattleEnded event")
+			Expect(ended[0].(*domain.BattleEnded).Winner).To(Equal(battle.GetWinner()))
+
+			deaths := 0
+			for _, action := range battle.GetActions() {
+				if action.GetType() == string(combatDomain.ActionDeath) {
+					deaths++
+				}
+			}
+			Expect(deaths).To(BeNumerically(">", 0), "expected combatants to die")
+			Expect(recorder.OfType(combatDomain.CombatantDiedEvent)).To(HaveLen(deaths))
+		})
+
+		It("should replay the battle to the same outcome", func() {
+			replay, err := battleService.Replay(ctx, battle.GetId(), -1)
+			Expect(err).ToNot(HaveOccurred(), "failed to replay battle")
+			Expect(replay.GetOutcome().Winner.String()).To(Equal(battle.GetWinner()))
+			Expect(replay.GetOutcome().Ticks).To(Equal(battle.GetTicks()))
+			Expect(replay.GetLog()).To(HaveLen(len(battle.GetActions())))
+		})
+
+		It("should replay the battle up to the requested tick", func() {
+			replay, err := battleService.Replay(ctx, battle.GetId(), 1)
+			Expect(err).ToNot(HaveOccurred(), "failed to replay battle")
+			Expect(replay.GetTick()).To(Equal(int32(1)))
+		})
+	})
+})
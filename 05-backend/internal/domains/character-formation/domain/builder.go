@@ -1,17 +1,24 @@
 package domain
 
 import (
-	"github.com/google/uuid"
+	"shvdg/crazed-conquerer/internal/shared/faker"
 )
 
 // CharacterFormationEntityBuilder helps build and configure a CharacterFormationEntity object.
 type CharacterFormationEntityBuilder struct {
 	characterFormationEntity *CharacterFormationEntity
+	faker                    *faker.Faker
 }
 
 // NewCharacterFormationEntity initializes a new CharacterFormationEntityBuilder with empty values.
 func NewCharacterFormationEntity() *CharacterFormationEntityBuilder {
-	return &CharacterFormationEntityBuilder{characterFormationEntity: &CharacterFormationEntity{}}
+	return &CharacterFormationEntityBuilder{characterFormationEntity: &CharacterFormationEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *CharacterFormationEntityBuilder) WithFaker(faker *faker.Faker) *CharacterFormationEntityBuilder {
+	b.faker = faker
+	return b
 }
 
 // WithCharacterId sets the character ID of the character formation entity.
@@ -22,7 +29,7 @@ func (b *CharacterFormationEntityBuilder) WithCharacterId(characterId string) *C
 
 // WithRandomCharacterId sets a random character ID (UUID) for the character formation entity.
 func (b *CharacterFormationEntityBuilder) WithRandomCharacterId() *CharacterFormationEntityBuilder {
-	b.characterFormationEntity.CharacterId = uuid.New().String()
+	b.characterFormationEntity.CharacterId = b.faker.UUID()
 	return b
 }
 
@@ -34,7 +41,7 @@ func (b *CharacterFormationEntityBuilder) WithId(id string) *CharacterFormationE
 
 // WithRandomId sets a random id for the character formation entity.
 func (b *CharacterFormationEntityBuilder) WithRandomId() *CharacterFormationEntityBuilder {
-	b.characterFormationEntity.FormationId = uuid.New().String()
+	b.characterFormationEntity.FormationId = b.faker.UUID()
 	return b
 }
 
@@ -0,0 +1,12 @@
+package infrastructure
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+)
+
+// Migrations returns the versioned changes to the character_formations table
+func Migrations() migrations.Domain {
+	return migrations.NewDomain(TableName, []string{"characters", "formations"},
+		migrations.NewMigration(1, "create character_formations table", CreateTableQuery, DropTableQuery),
+	)
+}
@@ -1,13 +1,14 @@
 package domain
 
 import (
-	"github.com/google/uuid"
+	"shvdg/crazed-conquerer/internal/shared/faker"
 )
 
 // CharacterUnitEntityBuilder helps build and configure a CharacterUnitEntity object.
 type CharacterUnitEntityBuilder struct {
 	characterUnitEntity *CharacterUnitEntity
 	counter             uint64
+	faker               *faker.Faker
 }
 
 // GetNumber returns the current number of character units.
@@ -17,7 +18,13 @@ func (b *CharacterUnitEntityBuilder) GetNumber() uint64 {
 
 // NewCharacterUnitEntity initializes a new CharacterUnitEntityBuilder with empty values.
 func NewCharacterUnitEntity() *CharacterUnitEntityBuilder {
-	return &CharacterUnitEntityBuilder{characterUnitEntity: &CharacterUnitEntity{}}
+	return &CharacterUnitEntityBuilder{characterUnitEntity: &CharacterUnitEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *CharacterUnitEntityBuilder) WithFaker(faker *faker.Faker) *CharacterUnitEntityBuilder {
+	b.faker = faker
+	return b
 }
 
 // WithCharacterId sets the character ID of the character unit entity.
@@ -28,7 +35,7 @@ func (b *CharacterUnitEntityBuilder) WithCharacterId(characterId string) *Charac
 
 // WithRandomCharacterId sets a random character ID (UUID) for the character unit entity.
 func (b *CharacterUnitEntityBuilder) WithRandomCharacterId() *CharacterUnitEntityBuilder {
-	b.characterUnitEntity.CharacterId = uuid.New().String()
+	b.characterUnitEntity.CharacterId = b.faker.UUID()
 	return b
 }
 
@@ -40,13 +47,13 @@ func (b *CharacterUnitEntityBuilder) WithUnitId(unitId string) *CharacterUnitEnt
 
 // WithRandomUnitId sets a random unit ID (UUID) for the character unit entity.
 func (b *CharacterUnitEntityBuilder) WithRandomUnitId() *CharacterUnitEntityBuilder {
-	b.characterUnitEntity.UnitId = uuid.New().String()
+	b.characterUnitEntity.UnitId = b.faker.UUID()
 	return b
 }
 
 // WithDefaults populates all fields with random default values.
 func (b *CharacterUnitEntityBuilder) WithDefaults() *CharacterUnitEntityBuilder {
-	b.counter = NextCharacterUnitNumber()
+	b.counter = b.faker.Next("character_unit")
 
 	return b.WithRandomCharacterId().
 		WithRandomUnitId()
@@ -0,0 +1,12 @@
+package infrastructure
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+)
+
+// Migrations returns the versioned changes to the character_units table
+func Migrations() migrations.Domain {
+	return migrations.NewDomain(TableName, []string{"characters", "units"},
+		migrations.NewMigration(1, "create character_units table", CreateTableQuery, DropTableQuery),
+	)
+}
@@ -4,18 +4,21 @@ import (
 	"context"
 	"errors"
 	"shvdg/crazed-conquerer/internal/domains/character-unit/domain"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
 	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
 	"shvdg/crazed-conquerer/internal/shared/sql"
 )
 
 // CharacterUnitRepositoryImpl provides the concrete implementation of the CharacterUnitRepository interface
 type CharacterUnitRepositoryImpl struct {
 	database.Connection
+	recorder events.Recorder
 }
 
-// NewCharacterUnitRepositoryImpl creates a new instance of CharacterUnitRepositoryImpl
-func NewCharacterUnitRepositoryImpl(connection database.Connection) *CharacterUnitRepositoryImpl {
-	return &CharacterUnitRepositoryImpl{connection}
+// NewCharacterUnitRepositoryImpl creates a new instance of CharacterUnitRepositoryImpl, the recorders receive the events it raises
+func NewCharacterUnitRepositoryImpl(connection database.Connection, recorders ...events.Recorder) *CharacterUnitRepositoryImpl {
+	return &CharacterUnitRepositoryImpl{Connection: connection, recorder: events.Recorders(recorders)}
 }
 
 // GetByCharacterId retrieves a character unit by their character ID
@@ -57,7 +60,15 @@ func (s *CharacterUnitRepositoryImpl) Create(ctx context.Context, entities ...*d
 		BatchValues(argSets).
 		BuildBatch()
 
-	return database.Batch(ctx, s.Connection, query, batchArgs)
+	if err := database.Batch(ctx, s.Connection, query, batchArgs); err != nil {
+		return err
+	}
+
+	raised := make([]events.Event, len(entities))
+	for i, entity := range entities {
+		raised[i] = unitDomain.NewUnitAssignedToCharacter(entity.GetUnitId(), entity.GetCharacterId())
+	}
+	return s.recorder.Record(ctx, raised...)
 }
 
 // Update is not supported for character-unit associations
@@ -25,6 +25,7 @@ var _ = Describe("CharacterUnit Repository", Ordered, func() {
 	var ctx context.Context
 
 	var suite *testing.Suite
+	var recorder *testing.EventRecorder
 	var characterRepo *characterInfra.CharacterRepositoryImpl
 	var unitRepo *unitInfra.UnitRepositoryImpl
 	var characterUnitRepo *infra.CharacterUnitRepositoryImpl
@@ -40,7 +41,8 @@ var _ = Describe("CharacterUnit Repository", Ordered, func() {
 		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
 
 		ctx = contexts.SetTransaction(suite.Context, transaction)
-		characterUnitRepo = infra.NewCharacterUnitRepositoryImpl(suite.Database)
+		recorder = testing.NewEventRecorder()
+		characterUnitRepo = infra.NewCharacterUnitRepositoryImpl(suite.Database, recorder)
 		characterRepo = characterInfra.NewCharacterRepositoryImpl(suite.Database)
 		unitRepo = unitInfra.NewUnitRepositoryImpl(suite.Database)
 
@@ -79,6 +81,13 @@ var _ = Describe("CharacterUnit Repository", Ordered, func() {
 			Expect(err).ToNot(HaveOccurred(), "failed to count character units")
 			Expect(count).To(Equal(1), "expected 1 character unit to be created")
 		})
+
+		It("should raise a UnitAssignedToCharacter event", func() {
+			raised := recorder.OfType(unitDomain.UnitAssignedToCharacterEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 UnitAssignedToCharacter event")
+			Expect(raised[0].AggregateID()).To(Equal(dummyCharacterUnit.GetUnitId()))
+			Expect(raised[0].(*unitDomain.UnitAssignedToCharacter).CharacterId).To(Equal(dummyCharacterUnit.GetCharacterId()))
+		})
 	})
 
 	Context("When retrieving character units by character ID", func() {
@@ -1 +1,77 @@
 package application
+
+import (
+	"context"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/character/domain"
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CharacterOwners tells which characters belong to which users
+type CharacterOwners interface {
+	GetCharacterIds(ctx context.Context, userId string) ([]string, error)
+	VerifyOwner(ctx context.Context, userId, characterId string) error
+}
+
+// CharacterService handles character-related operations on behalf of the user that owns the characters
+type CharacterService struct {
+	characters domain.CharacterRepository
+	owners     CharacterOwners
+}
+
+// NewCharacterService instantiates a new CharacterService instance
+func NewCharacterService(characters domain.CharacterRepository, owners CharacterOwners) *CharacterService {
+	return &CharacterService{characters: characters, owners: owners}
+}
+
+// GetCharacters returns the characters that belong to the user
+func (s *CharacterService) GetCharacters(ctx context.Context, userId string) ([]*domain.CharacterEntity, error) {
+	characterIds, err := s.owners.GetCharacterIds(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	characters, err := s.characters.GetByIds(ctx, characterIds...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters of user '%s': %w", userId, err)
+	}
+	return characters, nil
+}
+
+// GetCharacter returns the character once it is verified to belong to the user
+func (s *CharacterService) GetCharacter(ctx context.Context, userId, characterId string) (*domain.CharacterEntity, error) {
+	if err := s.owners.VerifyOwner(ctx, userId, characterId); err != nil {
+		return nil, err
+	}
+
+	character, err := s.characters.GetById(ctx, characterId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get character '%s': %w", characterId, err)
+	}
+	return character, nil
+}
+
+// CreateCharacter creates a character with a normalized name that belongs to the user.
+// ErrInvalidName is returned when the name breaks the rules for names.
+func (s *CharacterService) CreateCharacter(ctx context.Context, userId, name string) (*domain.CharacterEntity, error) {
+	name, err := domain.NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := converters.TimeToTimestamp(time.Now())
+	character := &domain.CharacterEntity{
+		Id:        uuid.NewString(),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.characters.CreateForUser(ctx, userId, character); err != nil {
+		return nil, fmt.Errorf("failed to create character for user '%s': %w", userId, err)
+	}
+	return character, nil
+}
@@ -3,16 +3,15 @@ package domain
 import (
 	"fmt"
 	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/faker"
 	"time"
-
-	fake "github.com/brianvoe/gofakeit/v6"
-	"github.com/google/uuid"
 )
 
 // CharacterEntityBuilder helps build and configure a CharacterEntity object.
 type CharacterEntityBuilder struct {
 	characterEntity *CharacterEntity
 	counter         uint64
+	faker           *faker.Faker
 }
 
 // GetNumber returns the current number of characters.
@@ -22,7 +21,13 @@ func (b *CharacterEntityBuilder) GetNumber() uint64 {
 
 // NewCharacterEntity initializes a new CharacterEntityBuilder with empty values.
 func NewCharacterEntity() *CharacterEntityBuilder {
-	return &CharacterEntityBuilder{characterEntity: &CharacterEntity{}}
+	return &CharacterEntityBuilder{characterEntity: &CharacterEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *CharacterEntityBuilder) WithFaker(faker *faker.Faker) *CharacterEntityBuilder {
+	b.faker = faker
+	return b
 }
 
 // WithId sets the ID of the characterEntity entity.
@@ -33,7 +38,7 @@ func (b *CharacterEntityBuilder) WithId(id string) *CharacterEntityBuilder {
 
 // WithRandomId sets a random ID (UUID) for the characterEntity entity.
 func (b *CharacterEntityBuilder) WithRandomId() *CharacterEntityBuilder {
-	b.characterEntity.Id = uuid.New().String()
+	b.characterEntity.Id = b.faker.UUID()
 	return b
 }
 
@@ -45,7 +50,7 @@ func (b *CharacterEntityBuilder) WithName(name string) *CharacterEntityBuilder {
 
 // WithRandomName sets a random name for the characterEntity entity.
 func (b *CharacterEntityBuilder) WithRandomName() *CharacterEntityBuilder {
-	b.characterEntity.Name = fmt.Sprintf("%s_%d", fake.FirstName(), b.counter)
+	b.characterEntity.Name = fmt.Sprintf("%s_%d", b.faker.FirstName(), b.counter)
 	return b
 }
 
@@ -63,7 +68,7 @@ func (b *CharacterEntityBuilder) WithUpdatedAt(t time.Time) *CharacterEntityBuil
 
 // WithDefaults populates all fields with random default values.
 func (b *CharacterEntityBuilder) WithDefaults() *CharacterEntityBuilder {
-	b.counter = NextCharacterNumber()
+	b.counter = b.faker.Next("character")
 
 	now := time.Now()
 	return b.WithRandomId().
@@ -0,0 +1,6 @@
+package domain
+
+import "errors"
+
+// ErrInvalidName is returned when the name of a character breaks the rules for names
+var ErrInvalidName = errors.New("character name is invalid")
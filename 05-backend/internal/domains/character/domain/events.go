@@ -1 +1,38 @@
 package domain
+
+import (
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/events"
+)
+
+// The types of the events raised by the character domain
+const (
+	CharacterCreatedEvent = "character.created"
+)
+
+// CharacterCreated is raised when a new character has been stored
+type CharacterCreated struct {
+	events.Base
+	CharacterId string
+	Name        string
+}
+
+// NewCharacterCreated creates the event raised when the character has been stored
+func NewCharacterCreated(character *CharacterEntity) *CharacterCreated {
+	return &CharacterCreated{Base: events.NewBase(character.GetId()), CharacterId: character.GetId(), Name: character.GetName()}
+}
+
+// Type implements events.Event
+func (e *CharacterCreated) Type() string { return CharacterCreatedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *CharacterCreated) Data() any {
+	return &CharacterCreatedPayload{CharacterId: e.CharacterId, Name: e.Name}
+}
+
+// RegisterEvents registers the payloads of the events of the domain
+func RegisterEvents(registry *events.Registry) error {
+	return errors.Join(
+		registry.Register(CharacterCreatedEvent, 1, &CharacterCreatedPayload{}),
+	)
+}
@@ -5,4 +5,6 @@ import "context"
 // CharacterRepository representation of a characterEntity repository
 type CharacterRepository interface {
 	GetById(ctx context.Context, id string) (*CharacterEntity, error)
+	GetByIds(ctx context.Context, ids ...string) ([]*CharacterEntity, error)
+	CreateForUser(ctx context.Context, userId string, entities ...*CharacterEntity) error
 }
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The limits of the name of a character
+const (
+	MinNameLength = 3
+	MaxNameLength = 32
+)
+
+// NormalizeName returns the name trimmed, or ErrInvalidName when it is too short or too long,
+// or contains other characters than letters, digits, spaces, underscores, dashes and dots
+func NormalizeName(name string) (string, error) {
+	normalized := strings.TrimSpace(name)
+
+	length := utf8.RuneCountInString(normalized)
+	if length < MinNameLength || length > MaxNameLength {
+		return "", fmt.Errorf("%w: should be between %d and %d characters", ErrInvalidName, MinNameLength, MaxNameLength)
+	}
+
+	for _, character := range normalized {
+		if !unicode.IsLetter(character) && !unicode.IsDigit(character) && !strings.ContainsRune(" _-.", character) {
+			return "", fmt.Errorf("%w: contains '%c'", ErrInvalidName, character)
+		}
+	}
+	return normalized, nil
+}
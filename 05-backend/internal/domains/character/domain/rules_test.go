@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Character Rules", func() {
+	Context("When a name is normalized", func() {
+		It("should trim it", func() {
+			Expect(NormalizeName("  Conqueror_1 ")).To(Equal("Conqueror_1"))
+		})
+
+		It("should refuse names that are too short, too long or contain symbols", func() {
+			for _, name := range []string{"", "  ab ", strings.Repeat("a", MaxNameLength+1), "drop;table"} {
+				_, err := NormalizeName(name)
+				Expect(err).To(MatchError(ErrInvalidName), "expected '%s' to be refused", name)
+			}
+		})
+	})
+})
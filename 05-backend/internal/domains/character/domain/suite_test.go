@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCharacter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Character Unit Tests")
+}
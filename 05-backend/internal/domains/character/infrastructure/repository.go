@@ -3,18 +3,22 @@ package infrastructure
 import (
 	"context"
 	"shvdg/crazed-conquerer/internal/domains/character/domain"
+	usercharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+	usercharacterinfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
 	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
 	"shvdg/crazed-conquerer/internal/shared/sql"
 )
 
 // CharacterRepositoryImpl provides the concrete implementation of the CharacterRepository interface
 type CharacterRepositoryImpl struct {
 	database.Connection
+	recorder events.Recorder
 }
 
-// NewCharacterRepositoryImpl creates a new instance of CharacterRepositoryImpl
-func NewCharacterRepositoryImpl(connection database.Connection) *CharacterRepositoryImpl {
-	return &CharacterRepositoryImpl{connection}
+// NewCharacterRepositoryImpl creates a new instance of CharacterRepositoryImpl, the recorders receive the events it raises
+func NewCharacterRepositoryImpl(connection database.Connection, recorders ...events.Recorder) *CharacterRepositoryImpl {
+	return &CharacterRepositoryImpl{Connection: connection, recorder: events.Recorders(recorders)}
 }
 
 // GetById retrieves a character by their ID
@@ -28,6 +32,26 @@ func (s *CharacterRepositoryImpl) GetById(ctx context.Context, id string) (*doma
 	return s.ReadOne(ctx, query, args, ScanCharacter)
 }
 
+// GetByIds retrieves the characters with the given IDs, IDs without a character are left out
+func (s *CharacterRepositoryImpl) GetByIds(ctx context.Context, ids ...string) ([]*domain.CharacterEntity, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	values := make([]any, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+
+	query, args := sql.NewQuery().
+		Select(FieldId, FieldName, FieldCreatedAt, FieldUpdatedAt).
+		From(TableName).
+		WhereIn(FieldId, values...).
+		Build()
+
+	return s.ReadMany(ctx, query, args, ScanCharacter)
+}
+
 // Create inserts one or more character entities into the database
 func (s *CharacterRepositoryImpl) Create(ctx context.Context, entities ...*domain.CharacterEntity) error {
 	if len(entities) == 0 {
@@ -45,7 +69,34 @@ func (s *CharacterRepositoryImpl) Create(ctx context.Context, entities ...*domai
 		BatchValues(argSets).
 		BuildBatch()
 
-	return database.Batch(ctx, s.Connection, query, batchArgs)
+	if err := database.Batch(ctx, s.Connection, query, batchArgs); err != nil {
+		return err
+	}
+
+	raised := make([]events.Event, len(entities))
+	for i, entity := range entities {
+		raised[i] = domain.NewCharacterCreated(entity)
+	}
+	return s.recorder.Record(ctx, raised...)
+}
+
+// CreateForUser inserts one or more character entities and assigns them to the user within a single transaction
+func (s *CharacterRepositoryImpl) CreateForUser(ctx context.Context, userId string, entities ...*domain.CharacterEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	userCharacters := make([]*usercharacterDomain.UserCharacterEntity, len(entities))
+	for i, entity := range entities {
+		userCharacters[i] = &usercharacterDomain.UserCharacterEntity{UserId: userId, CharacterId: entity.GetId()}
+	}
+
+	return database.InTransaction(ctx, s.Connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		if err := s.Create(ctx, entities...); err != nil {
+			return err
+		}
+		return usercharacterinfra.NewUserCharacterRepositoryImpl(s.Connection).Create(ctx, userCharacters...)
+	})
 }
 
 // Update modifies one or more character entities in the database
@@ -21,6 +21,7 @@ var _ = Describe("CharacterEntity Repository", Ordered, func() {
 	var ctx context.Context
 
 	var suite *testing.Suite
+	var recorder *testing.EventRecorder
 	var characterRepo *infra.CharacterRepositoryImpl
 
 	BeforeAll(func() {
@@ -29,7 +30,8 @@ var _ = Describe("CharacterEntity Repository", Ordered, func() {
 		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
 
 		ctx = contexts.SetTransaction(suite.Context, transaction)
-		characterRepo = infra.NewCharacterRepositoryImpl(suite.Database)
+		recorder = testing.NewEventRecorder()
+		characterRepo = infra.NewCharacterRepositoryImpl(suite.Database, recorder)
 	})
 
 	AfterAll(func() {
@@ -54,6 +56,35 @@ var _ = Describe("CharacterEntity Repository", Ordered, func() {
 			Expect(err).ToNot(HaveOccurred(), "failed to count characters")
 			Expect(count).To(Equal(1), "expected 1 character to be created")
 		})
+
+		It("should raise a CharacterCreated event", func() {
+			raised := recorder.OfType(domain.CharacterCreatedEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 CharacterCreated event")
+			Expect(raised[0].AggregateID()).To(Equal(character.GetId()))
+		})
+	})
+
+	Context("When retrieving characters by IDs", func() {
+		var first, second *domain.CharacterEntity
+
+		BeforeAll(func() {
+			first = domain.NewCharacterEntity().WithDefaults().Build()
+			second = domain.NewCharacterEntity().WithDefaults().Build()
+			err := characterRepo.Create(ctx, first, second)
+			Expect(err).ToNot(HaveOccurred(), "failed to create characters")
+		})
+
+		It("should return the characters with the given IDs", func() {
+			found, err := characterRepo.GetByIds(ctx, first.GetId(), second.GetId(), "unknown-id")
+			Expect(err).ToNot(HaveOccurred(), "failed to get characters by IDs")
+			Expect(found).To(HaveLen(2))
+		})
+
+		It("should return no characters when no IDs are given", func() {
+			found, err := characterRepo.GetByIds(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to get characters by IDs")
+			Expect(found).To(BeEmpty())
+		})
 	})
 
 	Context("When one character is updated", func() {
@@ -0,0 +1,93 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/character/application"
+	"shvdg/crazed-conquerer/internal/domains/character/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/character/infrastructure"
+	userCharacterApplication "shvdg/crazed-conquerer/internal/domains/user-character/application"
+	userCharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+	userCharacterInfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/fixtures"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Character Service", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var graph, other *fixtures.Graph
+	var characterService *application.CharacterService
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+
+		graph, err = fixtures.NewGraph().WithCharacters(2).WithUnitsPerCharacter(1).Create(ctx, suite.Database)
+		Expect(err).ToNot(HaveOccurred(), "failed to create graph")
+		other, err = fixtures.NewGraph().WithUnitsPerCharacter(1).Create(ctx, suite.Database)
+		Expect(err).ToNot(HaveOccurred(), "failed to create other graph")
+
+		owners := userCharacterApplication.NewUserCharacterService(userCharacterInfra.NewUserCharacterRepositoryImpl(suite.Database))
+		characterService = application.NewCharacterService(infra.NewCharacterRepositoryImpl(suite.Database), owners)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When the characters of a user are retrieved", func() {
+		It("should return only the characters of the user", func() {
+			characters, err := characterService.GetCharacters(ctx, graph.User.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get characters")
+			Expect(characters).To(HaveLen(2))
+		})
+
+		It("should return no characters for a user without characters", func() {
+			characters, err := characterService.GetCharacters(ctx, "user-without-characters")
+			Expect(err).ToNot(HaveOccurred(), "failed to get characters")
+			Expect(characters).To(BeEmpty())
+		})
+	})
+
+	Context("When a character is retrieved", func() {
+		It("should return a character of the user", func() {
+			character, err := characterService.GetCharacter(ctx, graph.User.GetId(), graph.Characters[0].Character.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get character")
+			Expect(character.GetName()).To(Equal(graph.Characters[0].Character.GetName()))
+		})
+
+		It("should refuse a character of another user", func() {
+			_, err := characterService.GetCharacter(ctx, graph.User.GetId(), other.Characters[0].Character.GetId())
+			Expect(err).To(MatchError(userCharacterDomain.ErrCharacterNotOwned))
+		})
+	})
+
+	Context("When a character is created", func() {
+		It("should create it with a normalized name for the user", func() {
+			character, err := characterService.CreateCharacter(ctx, other.User.GetId(), "  Newcomer ")
+			Expect(err).ToNot(HaveOccurred(), "failed to create character")
+			Expect(character.GetName()).To(Equal("Newcomer"))
+
+			stored, err := characterService.GetCharacter(ctx, other.User.GetId(), character.GetId())
+			Expect(err).ToNot(HaveOccurred(), "expected the character to belong to the user")
+			Expect(stored.GetName()).To(Equal("Newcomer"))
+		})
+
+		It("should refuse an invalid name", func() {
+			_, err := characterService.CreateCharacter(ctx, other.User.GetId(), "x")
+			Expect(err).To(MatchError(domain.ErrInvalidName))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/combat/domain"
+	formationDomain "shvdg/crazed-conquerer/internal/domains/formation/domain"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+	"strconv"
+)
+
+// CombatantService converts saved formations into combatants that can fight a battle
+type CombatantService struct {
+	catalogue  *domain.Catalogue
+	formations formationDomain.FormationRepository
+	units      unitDomain.UnitRepository
+}
+
+// NewCombatantService creates a new instance of CombatantService
+func NewCombatantService(catalogue *domain.Catalogue, formations formationDomain.FormationRepository, units unitDomain.UnitRepository) *CombatantService {
+	return &CombatantService{catalogue: catalogue, formations: formations, units: units}
+}
+
+// GetCombatants loads the formation and returns a combatant for every unit placed in it, fighting for the team
+func (s *CombatantService) GetCombatants(ctx context.Context, formationId string, team sharedDomain.Team) ([]*domain.Combatant, error) {
+	formation, err := s.formations.GetById(ctx, formationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get formation '%s': %w", formationId, err)
+	}
+
+	var combatants []*domain.Combatant
+	for _, row := range formation.GetRows() {
+		for _, column := range row.GetColumns() {
+			if column.GetUnitId() == "" {
+				continue
+			}
+
+			combatant, err := s.toCombatant(ctx, column, team)
+			if err != nil {
+				return nil, err
+			}
+			combatants = append(combatants, combatant)
+		}
+	}
+
+	return combatants, nil
+}
+
+// toCombatant loads the unit placed in the column and derives its combatant from the vocation and level
+func (s *CombatantService) toCombatant(ctx context.Context, column *formationDomain.FormationColumnEntity, team sharedDomain.Team) (*domain.Combatant, error) {
+	unit, err := s.units.GetById(ctx, column.GetUnitId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unit '%s': %w", column.GetUnitId(), err)
+	}
+
+	vocation, err := s.catalogue.GetVocation(unit.GetVocation())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vocation '%s' of unit '%s': %w", unit.GetVocation(), unit.GetId(), err)
+	}
+
+	level, err := strconv.ParseInt(unit.GetLevel(), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse level of unit '%s': %w", unit.GetId(), err)
+	}
+
+	combatant := &domain.Combatant{UnitId: unit.GetId(), Team: team}
+	vocation.Equip(combatant, int32(level))
+	combatant.Place(column.GetPositionX(), column.GetPositionY())
+
+	return combatant, nil
+}
@@ -0,0 +1,531 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand"
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+	"slices"
+	"sort"
+)
+
+// DefaultMaxTicks is the number of ticks after which an undecided battle ends in a draw
+const DefaultMaxTicks int32 = 10000
+
+// Outcome summarizes how a battle ended
+type Outcome struct {
+	Winner sharedDomain.Team
+	Ticks  int32
+}
+
+// Battle advances a set of combatants tick by tick until one team is eliminated
+type Battle struct {
+	seed       int64
+	random     *rand.Rand
+	combatants []*Combatant
+
+	tick     int32
+	maxTicks int32
+	finished bool
+	winner   sharedDomain.Team
+
+	log []Action
+}
+
+// BattleOpt configures the Battle during initialization
+type BattleOpt func(*Battle)
+
+// WithMaxTicks sets the number of ticks after which the battle ends in a draw
+func WithMaxTicks(maxTicks int32) BattleOpt {
+	return func(b *Battle) {
+		b.maxTicks = maxTicks
+	}
+}
+
+// NewBattle initializes a new Battle, the seed determines every random decision made during the battle
+func NewBattle(seed int64, combatants []*Combatant, options ...BattleOpt) *Battle {
+	battle := &Battle{
+		seed:       seed,
+		random:     rand.New(rand.NewSource(seed)),
+		combatants: combatants,
+		maxTicks:   DefaultMaxTicks,
+	}
+
+	for _, option := range options {
+		option(battle)
+	}
+
+	for _, combatant := range combatants {
+		combatant.Cooldowns = NewCooldowns(combatant)
+
+		coordinates := combatant.EffectiveCoordinates()
+		for _, name := range combatant.Modifications.Names() {
+			battle.record(Action{Type: ActionModifierApplied, TargetId: combatant.UnitId, Name: name, X: coordinates.X, Y: coordinates.Y})
+		}
+	}
+
+	battle.resolveOutcome()
+
+	return battle
+}
+
+// GetSeed returns the seed the battle was started with
+func (b *Battle) GetSeed() int64 {
+	return b.seed
+}
+
+// GetTick returns the number of ticks that have passed
+func (b *Battle) GetTick() int32 {
+	return b.tick
+}
+
+// GetCombatants returns all combatants, including the fallen ones
+func (b *Battle) GetCombatants() []*Combatant {
+	return b.combatants
+}
+
+// IsFinished returns whether the battle has ended
+func (b *Battle) IsFinished() bool {
+	return b.finished
+}
+
+// GetOutcome returns the outcome of the battle so far
+func (b *Battle) GetOutcome() Outcome {
+	return Outcome{Winner: b.winner, Ticks: b.tick}
+}
+
+// GetLog returns every action that happened so far, ordered by tick
+func (b *Battle) GetLog() []Action {
+	return b.log
+}
+
+// Run advances the battle until it has ended and returns the outcome
+func (b *Battle) Run() Outcome {
+	for b.Tick() {
+	}
+	return b.GetOutcome()
+}
+
+// Tick advances the battle by a single tick and returns whether the battle continues
+func (b *Battle) Tick() bool {
+	if b.finished {
+		return false
+	}
+
+	b.tick++
+	b.expireModifiers()
+
+	for _, combatant := range b.actingOrder() {
+		if !combatant.IsAlive() {
+			continue
+		}
+
+		combatant.Cooldowns.Tick()
+		b.act(combatant)
+	}
+
+	b.resolveOutcome()
+
+	return !b.finished
+}
+
+// expireModifiers counts the modifiers of every living combatant down before anyone acts, so expiry is resolved in one place.
+// Combatants whose health drops to zero when a modifier expires die, combatants that would be returned to an occupied
+// position stay where the expired modifier left them.
+func (b *Battle) expireModifiers() {
+	for _, combatant := range b.combatants {
+		if !combatant.IsAlive() {
+			continue
+		}
+
+		before := combatant.EffectiveCoordinates()
+		combatant.Modifications.Tick()
+
+		if !combatant.IsAlive() {
+			b.record(Action{Type: ActionDeath, TargetId: combatant.UnitId, X: before.X, Y: before.Y})
+			continue
+		}
+
+		b.settle(combatant, before)
+	}
+}
+
+// settle keeps the combatant at its previous location when removing its modifiers moved it onto an occupied position
+func (b *Battle) settle(combatant *Combatant, before *sharedDomain.Coordinates) {
+	after := combatant.EffectiveCoordinates()
+	if (after.X != before.X || after.Y != before.Y) && b.isOccupiedByOther(combatant, after.X, after.Y) {
+		combatant.MoveTo(before.X, before.Y)
+	}
+}
+
+// actingOrder returns the living combatants in the order they act during the current tick
+func (b *Battle) actingOrder() []*Combatant {
+	order := make([]*Combatant, 0, len(b.combatants))
+	for _, combatant := range b.combatants {
+		if combatant.IsAlive() {
+			order = append(order, combatant)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].UnitId < order[j].UnitId
+	})
+
+	b.random.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+
+	return order
+}
+
+// act lets the combatant perform the first action that is both ready and possible
+func (b *Battle) act(combatant *Combatant) {
+	if combatant.Cooldowns.Summoning == 0 && b.summon(combatant) {
+		combatant.Cooldowns.Summoning = combatant.EffectiveTicksBetweenSummoning()
+		return
+	}
+
+	if combatant.Cooldowns.Afflicting == 0 && b.afflict(combatant) {
+		combatant.Cooldowns.Afflicting = combatant.EffectiveTicksBetweenAfflicting()
+		return
+	}
+
+	if combatant.Cooldowns.Attacking == 0 && b.attack(combatant) {
+		combatant.Cooldowns.Attacking = combatant.EffectiveTicksBetweenAttacking()
+		return
+	}
+
+	if combatant.Cooldowns.Healing == 0 && b.heal(combatant) {
+		combatant.Cooldowns.Healing = combatant.EffectiveTicksBetweenHealing()
+		return
+	}
+
+	if combatant.Cooldowns.Dispelling == 0 && b.dispel(combatant) {
+		combatant.Cooldowns.Dispelling = combatant.EffectiveTicksBetweenDispelling()
+		return
+	}
+
+	if combatant.Cooldowns.Moving == 0 && b.move(combatant) {
+		combatant.Cooldowns.Moving = combatant.EffectiveTicksBetweenMoving()
+	}
+}
+
+// attack lets the attacker strike an enemy within range of one of its attacks
+func (b *Battle) attack(attacker *Combatant) bool {
+	for i := range attacker.Definitions.Attacks {
+		definition := &attacker.Definitions.Attacks[i]
+
+		targets := b.enemiesInRange(attacker, definition.Range)
+		if len(targets) == 0 {
+			continue
+		}
+
+		target := b.selectTarget(targets)
+		damage := max(attacker.EffectiveAttackPower()*definition.AttackPowerPercentage/100, 0)
+		target.State.Health -= damage
+
+		coordinates := target.EffectiveCoordinates()
+		b.record(Action{Type: ActionAttack, ActorId: attacker.UnitId, TargetId: target.UnitId, Name: definition.Name, Amount: damage, X: coordinates.X, Y: coordinates.Y})
+		if !target.IsAlive() {
+			b.record(Action{Type: ActionDeath, ActorId: attacker.UnitId, TargetId: target.UnitId, X: coordinates.X, Y: coordinates.Y})
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// heal lets the healer restore the health of a wounded ally within range of one of its heals
+func (b *Battle) heal(healer *Combatant) bool {
+	for i := range healer.Definitions.Heals {
+		definition := &healer.Definitions.Heals[i]
+
+		targets := b.woundedAlliesInRange(healer, definition.Range)
+		if len(targets) == 0 {
+			continue
+		}
+
+		target := b.selectTarget(targets)
+		amount := min(healer.EffectiveAttackPower(), target.State.MaxHealth-target.State.Health)
+		target.State.Health += amount
+
+		coordinates := target.EffectiveCoordinates()
+		b.record(Action{Type: ActionHeal, ActorId: healer.UnitId, TargetId: target.UnitId, Name: definition.Name, Amount: amount, X: coordinates.X, Y: coordinates.Y})
+
+		return true
+	}
+
+	return false
+}
+
+// afflict lets the afflicter apply the modifier of one of its afflictions to an enemy within range that does not carry it yet
+func (b *Battle) afflict(afflicter *Combatant) bool {
+	for i := range afflicter.Definitions.Afflicts {
+		definition := &afflicter.Definitions.Afflicts[i]
+
+		targets := b.combatantsInRange(afflicter, definition.Range, func(other *Combatant) bool {
+			return other.IsEnemyOf(afflicter) && !slices.Contains(other.Modifications.Names(), definition.Name)
+		})
+		if len(targets) == 0 {
+			continue
+		}
+
+		target := b.selectTarget(targets)
+		target.Modifications.Apply(definition.Name, &definition.Modifier)
+
+		coordinates := target.EffectiveCoordinates()
+		b.record(Action{Type: ActionModifierApplied, ActorId: afflicter.UnitId, TargetId: target.UnitId, Name: definition.Name, X: coordinates.X, Y: coordinates.Y})
+		if !target.IsAlive() {
+			b.record(Action{Type: ActionDeath, ActorId: afflicter.UnitId, TargetId: target.UnitId, X: coordinates.X, Y: coordinates.Y})
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// dispel lets the dispeller remove the harmful modifiers of an ally within range of one of its dispels
+func (b *Battle) dispel(dispeller *Combatant) bool {
+	for i := range dispeller.Definitions.Dispels {
+		definition := &dispeller.Definitions.Dispels[i]
+
+		targets := b.combatantsInRange(dispeller, definition.Range, func(other *Combatant) bool {
+			return !other.IsEnemyOf(dispeller) && other.Modifications.HasHarmful()
+		})
+		if len(targets) == 0 {
+			continue
+		}
+
+		target := b.selectTarget(targets)
+		before := target.EffectiveCoordinates()
+		removed := target.Modifications.Dispel()
+		b.settle(target, before)
+
+		coordinates := target.EffectiveCoordinates()
+		b.record(Action{Type: ActionDispel, ActorId: dispeller.UnitId, TargetId: target.UnitId, Name: definition.Name, Amount: int32(len(removed)), X: coordinates.X, Y: coordinates.Y})
+
+		return true
+	}
+
+	return false
+}
+
+// summon lets the summoner call a combatant onto a free position within range of one of its summons.
+// A summoner keeps at most one summoned combatant alive, which fights with the summoner's attacks and moves.
+func (b *Battle) summon(summoner *Combatant) bool {
+	if b.hasLivingSummon(summoner) {
+		return false
+	}
+
+	for i := range summoner.Definitions.Summons {
+		definition := &summoner.Definitions.Summons[i]
+
+		free := b.freeInRange(summoner, definition.Range)
+		if len(free) == 0 {
+			continue
+		}
+
+		chosen := free[b.random.Intn(len(free))]
+		summoned := &Combatant{
+			UnitId:     fmt.Sprintf("%s:%s:%d", summoner.UnitId, definition.Name, b.tick),
+			SummonerId: summoner.UnitId,
+			Team:       summoner.Team,
+			Definitions: Definitions{
+				Attacks: summoner.Definitions.Attacks,
+				Moves:   summoner.Definitions.Moves,
+			},
+			State: State{
+				Health:                max(summoner.State.MaxHealth*definition.HealthPercentage/100, 1),
+				AttackPower:           summoner.EffectiveAttackPower() * definition.AttackPowerPercentage / 100,
+				Coordinates:           sharedDomain.Coordinates{X: chosen.X, Y: chosen.Y},
+				Facing:                summoner.State.Facing,
+				TicksBetweenAttacking: summoner.State.TicksBetweenAttacking,
+				TicksBetweenMoving:    summoner.State.TicksBetweenMoving,
+			},
+		}
+		summoned.State.MaxHealth = summoned.State.Health
+		summoned.Cooldowns = NewCooldowns(summoned)
+		b.combatants = append(b.combatants, summoned)
+
+		b.record(Action{Type: ActionSummon, ActorId: summoner.UnitId, TargetId: summoned.UnitId, Name: definition.Name, X: chosen.X, Y: chosen.Y})
+
+		return true
+	}
+
+	return false
+}
+
+// hasLivingSummon returns whether a combatant summoned by the summoner is still alive
+func (b *Battle) hasLivingSummon(summoner *Combatant) bool {
+	for _, combatant := range b.combatants {
+		if combatant.SummonerId == summoner.UnitId && combatant.IsAlive() {
+			return true
+		}
+	}
+	return false
+}
+
+// woundedAlliesInRange returns the living allies within the offsets relative to the combatant that lost health
+func (b *Battle) woundedAlliesInRange(combatant *Combatant, offsets []sharedDomain.Coordinates) []*Combatant {
+	return b.combatantsInRange(combatant, offsets, func(other *Combatant) bool {
+		return !other.IsEnemyOf(combatant) && other.State.Health < other.State.MaxHealth
+	})
+}
+
+// enemiesInRange returns the living enemies within the offsets relative to the combatant
+func (b *Battle) enemiesInRange(combatant *Combatant, offsets []sharedDomain.Coordinates) []*Combatant {
+	return b.combatantsInRange(combatant, offsets, func(other *Combatant) bool {
+		return other.IsEnemyOf(combatant)
+	})
+}
+
+// combatantsInRange returns the living combatants within the offsets relative to the combatant that match the filter
+func (b *Battle) combatantsInRange(combatant *Combatant, offsets []sharedDomain.Coordinates, filter func(other *Combatant) bool) []*Combatant {
+	origin := combatant.EffectiveCoordinates()
+
+	var matches []*Combatant
+	for i := range offsets {
+		position := offsetFrom(origin, &offsets[i])
+		if other := b.combatantAt(position.X, position.Y); other != nil && filter(other) {
+			matches = append(matches, other)
+		}
+	}
+	return matches
+}
+
+// freeInRange returns the positions within the offsets relative to the combatant that no living combatant occupies
+func (b *Battle) freeInRange(combatant *Combatant, offsets []sharedDomain.Coordinates) []*sharedDomain.Coordinates {
+	origin := combatant.EffectiveCoordinates()
+
+	var free []*sharedDomain.Coordinates
+	for i := range offsets {
+		if position := offsetFrom(origin, &offsets[i]); b.combatantAt(position.X, position.Y) == nil {
+			free = append(free, position)
+		}
+	}
+	return free
+}
+
+// selectTarget returns the target with the least health, ties are broken at random
+func (b *Battle) selectTarget(targets []*Combatant) *Combatant {
+	var weakest []*Combatant
+	for _, target := range targets {
+		switch {
+		case len(weakest) == 0 || target.EffectiveHealth() < weakest[0].EffectiveHealth():
+			weakest = []*Combatant{target}
+		case target.EffectiveHealth() == weakest[0].EffectiveHealth():
+			weakest = append(weakest, target)
+		}
+	}
+	return weakest[b.random.Intn(len(weakest))]
+}
+
+// move lets the combatant step towards the nearest enemy using one of its moves
+func (b *Battle) move(combatant *Combatant) bool {
+	origin := combatant.EffectiveCoordinates()
+	closestDistance := b.distanceToNearestEnemy(combatant, origin.X, origin.Y)
+
+	type destination struct {
+		coordinates *sharedDomain.Coordinates
+		definition  *MoveDefinition
+	}
+	var closest []destination
+
+	for i := range combatant.Definitions.Moves {
+		definition := &combatant.Definitions.Moves[i]
+		graph := newMoveGraph(b, definition)
+
+		for _, reachable := range sharedDomain.FindReachable(graph, origin, moveBudget) {
+			distance := b.distanceToNearestEnemy(combatant, reachable.X, reachable.Y)
+			switch {
+			case distance < closestDistance:
+				closest = []destination{{reachable, definition}}
+				closestDistance = distance
+			case distance == closestDistance && len(closest) > 0:
+				closest = append(closest, destination{reachable, definition})
+			}
+		}
+	}
+
+	if len(closest) == 0 {
+		return false
+	}
+
+	chosen := closest[b.random.Intn(len(closest))]
+	combatant.MoveTo(chosen.coordinates.X, chosen.coordinates.Y)
+	b.record(Action{Type: ActionMove, ActorId: combatant.UnitId, Name: chosen.definition.Name, X: chosen.coordinates.X, Y: chosen.coordinates.Y})
+
+	return true
+}
+
+// distanceToNearestEnemy returns the distance from the given position to the nearest living enemy
+func (b *Battle) distanceToNearestEnemy(combatant *Combatant, x, y int32) int32 {
+	nearest := int32(-1)
+	for _, other := range b.combatants {
+		if !other.IsAlive() || !other.IsEnemyOf(combatant) {
+			continue
+		}
+
+		distance := sharedDomain.NewCoordinates(x, y).DistanceTo(other.EffectiveCoordinates())
+		if nearest < 0 || distance < nearest {
+			nearest = distance
+		}
+	}
+	return nearest
+}
+
+// combatantAt returns the living combatant located at the given coordinates, if any
+func (b *Battle) combatantAt(x, y int32) *Combatant {
+	for _, combatant := range b.combatants {
+		if combatant.IsAlive() && combatant.IsAt(x, y) {
+			return combatant
+		}
+	}
+	return nil
+}
+
+// isOccupiedByOther returns whether a living combatant other than the given one is located at the given coordinates
+func (b *Battle) isOccupiedByOther(combatant *Combatant, x, y int32) bool {
+	for _, other := range b.combatants {
+		if other != combatant && other.IsAlive() && other.IsAt(x, y) {
+			return true
+		}
+	}
+	return false
+}
+
+// record appends the action to the log of the battle at the current tick
+func (b *Battle) record(action Action) {
+	action.Tick = b.tick
+	b.log = append(b.log, action)
+}
+
+// resolveOutcome ends the battle when at most one team is left standing or the tick limit is reached
+func (b *Battle) resolveOutcome() {
+	standing := make(map[sharedDomain.Team]bool)
+	for _, combatant := range b.combatants {
+		if combatant.IsAlive() {
+			standing[combatant.Team] = true
+		}
+	}
+
+	switch {
+	case len(standing) == 1:
+		for team := range standing {
+			b.winner = team
+		}
+		b.finished = true
+	case len(standing) == 0:
+		b.winner = sharedDomain.Team_TEAM_NONE
+		b.finished = true
+	case b.tick >= b.maxTicks:
+		b.winner = sharedDomain.Team_TEAM_NONE
+		b.finished = true
+	}
+}
+
+// offsetFrom returns the position at the axial offset from the origin, which covers the same hex direction from every column
+func offsetFrom(origin *sharedDomain.Coordinates, offset *sharedDomain.Coordinates) *sharedDomain.Coordinates {
+	return origin.ToAxial().Add(sharedDomain.NewAxialCoordinates(offset.X, offset.Y)).ToCoordinates()
+}
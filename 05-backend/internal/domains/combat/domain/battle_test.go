@@ -0,0 +1,270 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+// adjacent contains the axial offsets of all six positions next to a combatant
+var adjacent = []sharedDomain.Coordinates{{Y: -1}, {X: 1, Y: -1}, {X: 1}, {Y: 1}, {X: -1, Y: 1}, {X: -1}}
+
+// newTestCombatant creates a melee combatant at the given position
+func newTestCombatant(unitId string, team sharedDomain.Team, x, y, health, attackPower int32) *Combatant {
+	return &Combatant{
+		UnitId: unitId,
+		Team:   team,
+		Definitions: Definitions{
+			Attacks: []AttackDefinition{{Name: "strike", AttackPowerPercentage: 100, Range: adjacent}},
+			Moves:   []MoveDefinition{{Name: "step", Range: adjacent}},
+		},
+		State: State{
+			Health:                health,
+			AttackPower:           attackPower,
+			Coordinates:           sharedDomain.Coordinates{X: x, Y: y},
+			TicksBetweenAttacking: 2,
+			TicksBetweenMoving:    1,
+		},
+	}
+}
+
+var _ = Describe("Battle", func() {
+
+	Context("When one team is missing", func() {
+		It("should be finished immediately", func() {
+			battle := NewBattle(1, []*Combatant{
+				newTestCombatant("a", sharedDomain.Team_TEAM_PLAYER, 0, 0, 10, 5),
+			})
+
+			Expect(battle.IsFinished()).To(BeTrue())
+			Expect(battle.GetOutcome()).To(Equal(Outcome{Winner: sharedDomain.Team_TEAM_PLAYER, Ticks: 0}))
+		})
+	})
+
+	Context("When two adjacent combatants fight", func() {
+		It("should let the stronger combatant win", func() {
+			strong := newTestCombatant("strong", sharedDomain.Team_TEAM_PLAYER, 0, 0, 20, 10)
+			weak := newTestCombatant("weak", sharedDomain.Team_TEAM_OPPONENT, 1, 0, 20, 5)
+
+			outcome := NewBattle(7, []*Combatant{strong, weak}).Run()
+
+			Expect(outcome.Winner).To(Equal(sharedDomain.Team_TEAM_PLAYER))
+			Expect(outcome.Ticks).To(Equal(int32(4)))
+			Expect(strong.State.Health).To(Equal(int32(10)))
+			Expect(weak.IsAlive()).To(BeFalse())
+		})
+	})
+
+	Context("When combatants start apart", func() {
+		It("should move them towards each other", func() {
+			player := newTestCombatant("player", sharedDomain.Team_TEAM_PLAYER, 0, 0, 10, 1)
+			opponent := newTestCombatant("opponent", sharedDomain.Team_TEAM_OPPONENT, 5, 0, 10, 1)
+
+			battle := NewBattle(3, []*Combatant{player, opponent})
+			battle.Tick()
+			battle.Tick()
+
+			Expect(player.EffectiveCoordinates().DistanceTo(opponent.EffectiveCoordinates())).To(BeNumerically("<", 5))
+		})
+	})
+
+	Context("When the tick limit is reached", func() {
+		It("should end in a draw", func() {
+			player := newTestCombatant("player", sharedDomain.Team_TEAM_PLAYER, 0, 0, 10, 0)
+			opponent := newTestCombatant("opponent", sharedDomain.Team_TEAM_OPPONENT, 1, 0, 10, 0)
+
+			outcome := NewBattle(3, []*Combatant{player, opponent}, WithMaxTicks(25)).Run()
+
+			Expect(outcome).To(Equal(Outcome{Winner: sharedDomain.Team_TEAM_NONE, Ticks: 25}))
+		})
+	})
+
+	Context("When a health modifier expires", func() {
+		It("should record the death of the combatant it kept alive", func() {
+			shielded := newTestCombatant("shielded", sharedDomain.Team_TEAM_PLAYER, 0, 0, 0, 1)
+			shielded.Modifications.AddHealthModifier(&HealthModifier{Name: "shield", HealthDelta: 10, DurationTicks: 1}, StackingRefresh)
+			opponent := newTestCombatant("opponent", sharedDomain.Team_TEAM_OPPONENT, 10, 0, 10, 1)
+
+			battle := NewBattle(5, []*Combatant{shielded, opponent})
+			Expect(battle.Tick()).To(BeFalse())
+
+			Expect(shielded.IsAlive()).To(BeFalse())
+			Expect(battle.GetLog()).To(ContainElement(Action{Tick: 1, Type: ActionDeath, TargetId: "shielded", X: 0, Y: 0}))
+			Expect(battle.GetOutcome()).To(Equal(Outcome{Winner: sharedDomain.Team_TEAM_OPPONENT, Ticks: 1}))
+		})
+	})
+
+	Context("When a location modifier expires", func() {
+		var displaced, ally, opponent *Combatant
+
+		BeforeEach(func() {
+			displaced = newTestCombatant("displaced", sharedDomain.Team_TEAM_PLAYER, 0, 0, 10, 1)
+			displaced.Modifications.AddLocationModifier(&LocationModifier{Name: "knockback", CoordinatesDelta: sharedDomain.Coordinates{X: 3}, DurationTicks: 1}, StackingRefresh)
+			ally = newTestCombatant("ally", sharedDomain.Team_TEAM_PLAYER, 0, 5, 10, 1)
+			opponent = newTestCombatant("opponent", sharedDomain.Team_TEAM_OPPONENT, 20, 0, 10, 1)
+
+			for _, combatant := range []*Combatant{displaced, ally, opponent} {
+				combatant.State.TicksBetweenMoving = 10
+			}
+		})
+
+		It("should return the combatant to its position when it is free", func() {
+			NewBattle(5, []*Combatant{displaced, ally, opponent}).Tick()
+
+			Expect(proto.Equal(displaced.EffectiveCoordinates(), sharedDomain.NewCoordinates(0, 0))).To(BeTrue())
+		})
+
+		It("should keep the combatant in place when its position is occupied", func() {
+			ally.State.Coordinates = sharedDomain.Coordinates{X: 0, Y: 0}
+
+			NewBattle(5, []*Combatant{displaced, ally, opponent}).Tick()
+
+			Expect(displaced.Modifications.LocationModifiers).To(BeEmpty())
+			Expect(proto.Equal(displaced.EffectiveCoordinates(), sharedDomain.NewCoordinates(3, 0))).To(BeTrue())
+			Expect(proto.Equal(ally.EffectiveCoordinates(), sharedDomain.NewCoordinates(0, 0))).To(BeTrue())
+		})
+	})
+
+	Context("When an enemy stands next to a combatant in an odd column", func() {
+		It("should be within range of the combatant's attacks", func() {
+			attacker := newTestCombatant("attacker", sharedDomain.Team_TEAM_PLAYER, 1, 0, 10, 3)
+			enemy := newTestCombatant("enemy", sharedDomain.Team_TEAM_OPPONENT, 2, 1, 10, 0)
+			attacker.Definitions.Moves, enemy.Definitions.Moves = nil, nil
+
+			battle := NewBattle(1, []*Combatant{attacker, enemy})
+			battle.Tick()
+			battle.Tick()
+
+			Expect(battle.GetLog()).To(ContainElement(Action{Tick: 2, Type: ActionAttack, ActorId: "attacker", TargetId: "enemy", Name: "strike", Amount: 3, X: 2, Y: 1}))
+		})
+	})
+
+	Context("When a combatant afflicts an enemy", func() {
+		var afflicter, enemy *Combatant
+
+		BeforeEach(func() {
+			afflicter = newTestCombatant("afflicter", sharedDomain.Team_TEAM_PLAYER, 0, 0, 10, 0)
+			afflicter.Definitions.Afflicts = []AfflictDefinition{{
+				Name:     "weaken",
+				Range:    adjacent,
+				Modifier: ModifierDefinition{Kind: ModifierAttackPower, Delta: -3, DurationTicks: 5, Stacking: StackingRefresh},
+			}}
+			enemy = newTestCombatant("enemy", sharedDomain.Team_TEAM_OPPONENT, 1, 0, 10, 4)
+		})
+
+		It("should apply the modifier of the affliction to the enemy", func() {
+			battle := NewBattle(1, []*Combatant{afflicter, enemy})
+			battle.Tick()
+
+			Expect(enemy.EffectiveAttackPower()).To(Equal(int32(1)))
+			Expect(battle.GetLog()).To(ContainElement(Action{Tick: 1, Type: ActionModifierApplied, ActorId: "afflicter", TargetId: "enemy", Name: "weaken", X: 1, Y: 0}))
+		})
+
+		It("should not afflict an enemy that carries the affliction already", func() {
+			battle := NewBattle(1, []*Combatant{afflicter, enemy}, WithMaxTicks(3))
+			battle.Run()
+
+			applied := 0
+			for _, action := range battle.GetLog() {
+				if action.Type == ActionModifierApplied {
+					applied++
+				}
+			}
+			Expect(applied).To(Equal(1))
+		})
+	})
+
+	Context("When a combatant dispels an ally", func() {
+		It("should remove the harmful modifiers only", func() {
+			dispeller := newTestCombatant("dispeller", sharedDomain.Team_TEAM_PLAYER, 0, 0, 10, 0)
+			dispeller.Definitions.Dispels = []DispelDefinition{{Name: "cleanse", Range: adjacent}}
+			ally := newTestCombatant("ally", sharedDomain.Team_TEAM_PLAYER, 1, 0, 10, 5)
+			ally.Definitions.Moves = nil
+			ally.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "weaken", AttackDelta: -3, DurationTicks: 5}, StackingRefresh)
+			ally.Modifications.AddTicksBetweenMovingModifier(&TicksBetweenMovingModifier{Name: "haste", TicksDelta: -1, DurationTicks: 5}, StackingRefresh)
+			enemy := newTestCombatant("enemy", sharedDomain.Team_TEAM_OPPONENT, 9, 9, 10, 1)
+
+			battle := NewBattle(1, []*Combatant{dispeller, ally, enemy})
+			battle.Tick()
+
+			Expect(ally.Modifications.Names()).To(ConsistOf("haste"))
+			Expect(ally.EffectiveAttackPower()).To(Equal(int32(5)))
+			Expect(battle.GetLog()).To(ContainElement(Action{Tick: 1, Type: ActionDispel, ActorId: "dispeller", TargetId: "ally", Name: "cleanse", Amount: 1, X: 1, Y: 0}))
+		})
+	})
+
+	Context("When a combatant summons", func() {
+		var summoner, enemy *Combatant
+
+		BeforeEach(func() {
+			summoner = newTestCombatant("summoner", sharedDomain.Team_TEAM_PLAYER, 0, 0, 20, 6)
+			summoner.State.MaxHealth = 20
+			summoner.Definitions.Summons = []SummonDefinition{{Name: "familiar", Range: adjacent, HealthPercentage: 50, AttackPowerPercentage: 50}}
+			enemy = newTestCombatant("enemy", sharedDomain.Team_TEAM_OPPONENT, 9, 9, 10, 1)
+		})
+
+		It("should spawn an allied combatant on a free position next to the summoner", func() {
+			battle := NewBattle(1, []*Combatant{summoner, enemy})
+			battle.Tick()
+
+			Expect(battle.GetCombatants()).To(HaveLen(3))
+			summoned := battle.GetCombatants()[2]
+			Expect(summoned.SummonerId).To(Equal("summoner"))
+			Expect(summoned.Team).To(Equal(sharedDomain.Team_TEAM_PLAYER))
+			Expect(summoned.State.Health).To(Equal(int32(10)))
+			Expect(summoned.State.AttackPower).To(Equal(int32(3)))
+			Expect(summoned.Definitions.Attacks).To(Equal(summoner.Definitions.Attacks))
+
+			coordinates := summoned.EffectiveCoordinates()
+			Expect(coordinates.DistanceTo(sharedDomain.NewCoordinates(0, 0))).To(Equal(int32(1)))
+			Expect(battle.GetLog()).To(ContainElement(Action{Tick: 1, Type: ActionSummon, ActorId: "summoner", TargetId: summoned.UnitId, Name: "familiar", X: coordinates.X, Y: coordinates.Y}))
+		})
+
+		It("should keep at most one summoned combatant alive", func() {
+			battle := NewBattle(1, []*Combatant{summoner, enemy}, WithMaxTicks(3))
+			battle.Run()
+
+			Expect(battle.GetCombatants()).To(HaveLen(3))
+		})
+
+		It("should summon again once the summoned combatant died", func() {
+			battle := NewBattle(1, []*Combatant{summoner, enemy})
+			battle.Tick()
+			battle.GetCombatants()[2].State.Health = 0
+			battle.Tick()
+
+			Expect(battle.GetCombatants()).To(HaveLen(4))
+			Expect(battle.GetCombatants()[3].IsAlive()).To(BeTrue())
+		})
+	})
+
+	Context("When the same battle is fought twice with the same seed", func() {
+		var createCombatants = func() []*Combatant {
+			return []*Combatant{
+				newTestCombatant("p1", sharedDomain.Team_TEAM_PLAYER, 0, 0, 30, 4),
+				newTestCombatant("p2", sharedDomain.Team_TEAM_PLAYER, 0, 2, 25, 5),
+				newTestCombatant("p3", sharedDomain.Team_TEAM_PLAYER, 0, 4, 20, 6),
+				newTestCombatant("o1", sharedDomain.Team_TEAM_OPPONENT, 6, 0, 30, 4),
+				newTestCombatant("o2", sharedDomain.Team_TEAM_OPPONENT, 6, 2, 25, 5),
+				newTestCombatant("o3", sharedDomain.Team_TEAM_OPPONENT, 6, 4, 20, 6),
+			}
+		}
+
+		It("should produce the exact same outcome", func() {
+			first := createCombatants()
+			second := createCombatants()
+
+			firstOutcome := NewBattle(42, first).Run()
+			secondOutcome := NewBattle(42, second).Run()
+
+			Expect(firstOutcome).To(Equal(secondOutcome))
+			for i := range first {
+				Expect(first[i].State.Health).To(Equal(second[i].State.Health))
+				Expect(first[i].State.Coordinates.X).To(Equal(second[i].State.Coordinates.X))
+				Expect(first[i].State.Coordinates.Y).To(Equal(second[i].State.Coordinates.Y))
+			}
+		})
+	})
+})
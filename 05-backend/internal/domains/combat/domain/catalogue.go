@@ -0,0 +1,284 @@
+package domain
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+	"slices"
+)
+
+// CatalogueVersion is the version of the catalogue file this code understands
+const CatalogueVersion int32 = 1
+
+// The kinds of abilities a vocation can grant
+const (
+	AbilityAttack  = "attack"
+	AbilityMove    = "move"
+	AbilityHeal    = "heal"
+	AbilityAfflict = "afflict"
+	AbilityDispel  = "dispel"
+	AbilitySummon  = "summon"
+)
+
+// Errors returned when the catalogue is loaded
+var (
+	ErrUnknownVocation     = errors.New("unknown vocation")
+	ErrInvalidCatalogue    = errors.New("invalid vocation catalogue")
+	ErrUnsupportedVersion  = errors.New("unsupported catalogue version")
+	ErrMissingVocation     = errors.New("vocation is missing from the catalogue")
+	ErrDuplicateVocation   = errors.New("vocation is defined more than once")
+	ErrUnknownAbilityKind  = errors.New("unknown ability kind")
+	ErrUnknownAbilityRange = errors.New("unknown ability range")
+	ErrUnknownModifierKind = errors.New("unknown modifier kind")
+	ErrUnknownStacking     = errors.New("unknown stacking rule")
+)
+
+// stackingRules maps the stacking rules of the catalogue onto those of the modifiers, refreshing when none is given
+var stackingRules = map[string]StackingRule{
+	"":        StackingRefresh,
+	"refresh": StackingRefresh,
+	"stack":   StackingStack,
+	"ignore":  StackingIgnore,
+}
+
+//go:embed catalogue/vocations.v1.json
+var catalogueFile []byte
+
+// Stats contains the values a vocation provides to a combatant
+type Stats struct {
+	Health                 int32 `json:"health"`
+	AttackPower            int32 `json:"attack_power"`
+	TicksBetweenDispelling int32 `json:"ticks_between_dispelling"`
+	TicksBetweenAfflicting int32 `json:"ticks_between_afflicting"`
+	TicksBetweenAttacking  int32 `json:"ticks_between_attacking"`
+	TicksBetweenMoving     int32 `json:"ticks_between_moving"`
+	TicksBetweenHealing    int32 `json:"ticks_between_healing"`
+	TicksBetweenSummoning  int32 `json:"ticks_between_summoning"`
+}
+
+// Ability is granted by a vocation once a unit reaches its level.
+// Afflictions carry the modifier they apply, summons the share of health and attack power the summoned combatant gets.
+type Ability struct {
+	Kind                  string           `json:"kind"`
+	Name                  string           `json:"name"`
+	Level                 int32            `json:"level"`
+	AttackPowerPercentage int32            `json:"attack_power_percentage"`
+	HealthPercentage      int32            `json:"health_percentage"`
+	Range                 string           `json:"range"`
+	Modifier              *AbilityModifier `json:"modifier,omitempty"`
+
+	offsets []sharedDomain.Coordinates
+}
+
+// AbilityModifier describes the modifier an affliction applies
+type AbilityModifier struct {
+	Kind          string `json:"kind"`
+	Delta         int32  `json:"delta"`
+	DurationTicks int32  `json:"duration_ticks"`
+	Stacking      string `json:"stacking"`
+}
+
+// Vocation contains the stats of a vocation at level one, how they grow per level and the abilities it grants
+type Vocation struct {
+	Vocation  string    `json:"vocation"`
+	Base      Stats     `json:"base"`
+	PerLevel  Stats     `json:"per_level"`
+	Abilities []Ability `json:"abilities"`
+}
+
+// Catalogue contains the stats and abilities of every vocation
+type Catalogue struct {
+	Version   int32                 `json:"version"`
+	Ranges    map[string][][2]int32 `json:"ranges"`
+	Vocations []*Vocation           `json:"vocations"`
+
+	vocations map[string]*Vocation
+}
+
+// LoadCatalogue parses and validates the catalogue embedded in the binary
+func LoadCatalogue() (*Catalogue, error) {
+	return ParseCatalogue(catalogueFile)
+}
+
+// ParseCatalogue parses and validates the catalogue from JSON
+func ParseCatalogue(data []byte) (*Catalogue, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	catalogue := &Catalogue{}
+	if err := decoder.Decode(catalogue); err != nil {
+		return nil, fmt.Errorf("failed to parse vocation catalogue: %w", errors.Join(ErrInvalidCatalogue, err))
+	}
+
+	if err := catalogue.validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate vocation catalogue: %w", err)
+	}
+
+	return catalogue, nil
+}
+
+// GetVocation returns the stats of the vocation
+func (c *Catalogue) GetVocation(name string) (*Vocation, error) {
+	vocation, found := c.vocations[name]
+	if !found {
+		return nil, ErrUnknownVocation
+	}
+	return vocation, nil
+}
+
+// validate checks the catalogue covers every vocation exactly once with sensible values, and resolves the ranges
+func (c *Catalogue) validate() error {
+	if c.Version != CatalogueVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, c.Version)
+	}
+
+	var errs []error
+	c.vocations = make(map[string]*Vocation, len(c.Vocations))
+	for _, vocation := range c.Vocations {
+		if _, found := unitDomain.Vocation_value[vocation.Vocation]; !found || vocation.Vocation == unitDomain.Vocation_VOCATION_NONE.String() {
+			errs = append(errs, fmt.Errorf("%w: '%s'", ErrUnknownVocation, vocation.Vocation))
+			continue
+		}
+		if _, found := c.vocations[vocation.Vocation]; found {
+			errs = append(errs, fmt.Errorf("%w: '%s'", ErrDuplicateVocation, vocation.Vocation))
+			continue
+		}
+
+		c.vocations[vocation.Vocation] = vocation
+		errs = append(errs, c.validateVocation(vocation)...)
+	}
+
+	for _, value := range slices.Sorted(maps.Keys(unitDomain.Vocation_name)) {
+		name := unitDomain.Vocation_name[value]
+		if _, found := c.vocations[name]; !found && value != int32(unitDomain.Vocation_VOCATION_NONE) {
+			errs = append(errs, fmt.Errorf("%w: '%s'", ErrMissingVocation, name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateVocation returns the problems found within the stats and abilities of the vocation
+func (c *Catalogue) validateVocation(vocation *Vocation) []error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: vocation '%s' %s", ErrInvalidCatalogue, vocation.Vocation, fmt.Sprintf(format, args...)))
+	}
+
+	if vocation.Base.Health <= 0 {
+		invalid("has no base health")
+	}
+	if vocation.Base.AttackPower < 0 || vocation.PerLevel.Health < 0 || vocation.PerLevel.AttackPower < 0 {
+		invalid("has negative health or attack power")
+	}
+
+	for i := range vocation.Abilities {
+		ability := &vocation.Abilities[i]
+
+		switch ability.Kind {
+		case AbilityAttack, AbilityMove, AbilityHeal, AbilityAfflict, AbilityDispel, AbilitySummon:
+		default:
+			errs = append(errs, fmt.Errorf("%w: '%s' of vocation '%s'", ErrUnknownAbilityKind, ability.Kind, vocation.Vocation))
+		}
+
+		offsets, found := c.Ranges[ability.Range]
+		if !found {
+			errs = append(errs, fmt.Errorf("%w: '%s' of vocation '%s'", ErrUnknownAbilityRange, ability.Range, vocation.Vocation))
+		}
+		ability.offsets = make([]sharedDomain.Coordinates, len(offsets))
+		for j, offset := range offsets {
+			ability.offsets[j] = sharedDomain.Coordinates{X: offset[0], Y: offset[1]}
+		}
+
+		if ability.Name == "" {
+			invalid("has an ability without a name")
+		}
+		if ability.Level < 1 {
+			invalid("grants '%s' below level one", ability.Name)
+		}
+		if ability.Kind == AbilityAttack && ability.AttackPowerPercentage <= 0 {
+			invalid("has attack '%s' without attack power", ability.Name)
+		}
+		if ability.Kind == AbilitySummon && (ability.HealthPercentage <= 0 || ability.AttackPowerPercentage < 0) {
+			invalid("has summon '%s' without health or with negative attack power", ability.Name)
+		}
+		if ability.Kind == AbilityAfflict {
+			errs = append(errs, validateModifier(vocation, ability)...)
+		}
+	}
+
+	return errs
+}
+
+// validateModifier returns the problems found within the modifier of the affliction
+func validateModifier(vocation *Vocation, ability *Ability) []error {
+	if ability.Modifier == nil {
+		return []error{fmt.Errorf("%w: vocation '%s' has affliction '%s' without a modifier", ErrInvalidCatalogue, vocation.Vocation, ability.Name)}
+	}
+
+	var errs []error
+	if !slices.Contains(ModifierKinds, ModifierKind(ability.Modifier.Kind)) {
+		errs = append(errs, fmt.Errorf("%w: '%s' of vocation '%s'", ErrUnknownModifierKind, ability.Modifier.Kind, vocation.Vocation))
+	}
+	if _, found := stackingRules[ability.Modifier.Stacking]; !found {
+		errs = append(errs, fmt.Errorf("%w: '%s' of vocation '%s'", ErrUnknownStacking, ability.Modifier.Stacking, vocation.Vocation))
+	}
+	if ability.Modifier.DurationTicks <= 0 {
+		errs = append(errs, fmt.Errorf("%w: vocation '%s' has affliction '%s' without a duration", ErrInvalidCatalogue, vocation.Vocation, ability.Name))
+	}
+	return errs
+}
+
+// Equip sets the base state and the definitions of the combatant for the given level
+func (v *Vocation) Equip(combatant *Combatant, level int32) {
+	level = max(level, 1)
+	growth := level - 1
+	grow := func(base, perLevel int32) int32 {
+		return max(base+perLevel*growth, 0)
+	}
+
+	combatant.State.Health = grow(v.Base.Health, v.PerLevel.Health)
+	combatant.State.MaxHealth = combatant.State.Health
+	combatant.State.AttackPower = grow(v.Base.AttackPower, v.PerLevel.AttackPower)
+	combatant.State.TicksBetweenDispelling = grow(v.Base.TicksBetweenDispelling, v.PerLevel.TicksBetweenDispelling)
+	combatant.State.TicksBetweenAfflicting = grow(v.Base.TicksBetweenAfflicting, v.PerLevel.TicksBetweenAfflicting)
+	combatant.State.TicksBetweenAttacking = grow(v.Base.TicksBetweenAttacking, v.PerLevel.TicksBetweenAttacking)
+	combatant.State.TicksBetweenMoving = grow(v.Base.TicksBetweenMoving, v.PerLevel.TicksBetweenMoving)
+	combatant.State.TicksBetweenHealing = grow(v.Base.TicksBetweenHealing, v.PerLevel.TicksBetweenHealing)
+	combatant.State.TicksBetweenSummoning = grow(v.Base.TicksBetweenSummoning, v.PerLevel.TicksBetweenSummoning)
+
+	definitions := Definitions{}
+	for i := range v.Abilities {
+		ability := &v.Abilities[i]
+		if ability.Level > level {
+			continue
+		}
+
+		switch ability.Kind {
+		case AbilityAttack:
+			definitions.Attacks = append(definitions.Attacks, AttackDefinition{Name: ability.Name, AttackPowerPercentage: ability.AttackPowerPercentage, Range: ability.offsets})
+		case AbilityMove:
+			definitions.Moves = append(definitions.Moves, MoveDefinition{Name: ability.Name, Range: ability.offsets})
+		case AbilityHeal:
+			definitions.Heals = append(definitions.Heals, HealDefinition{Name: ability.Name, Range: ability.offsets})
+		case AbilityAfflict:
+			definitions.Afflicts = append(definitions.Afflicts, AfflictDefinition{Name: ability.Name, Range: ability.offsets, Modifier: ModifierDefinition{
+				Kind:          ModifierKind(ability.Modifier.Kind),
+				Delta:         ability.Modifier.Delta,
+				DurationTicks: ability.Modifier.DurationTicks,
+				Stacking:      stackingRules[ability.Modifier.Stacking],
+			}})
+		case AbilityDispel:
+			definitions.Dispels = append(definitions.Dispels, DispelDefinition{Name: ability.Name, Range: ability.offsets})
+		case AbilitySummon:
+			definitions.Summons = append(definitions.Summons, SummonDefinition{Name: ability.Name, Range: ability.offsets,
+				HealthPercentage: ability.HealthPercentage, AttackPowerPercentage: ability.AttackPowerPercentage})
+		}
+	}
+	combatant.Definitions = definitions
+}
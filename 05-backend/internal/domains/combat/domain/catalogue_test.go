@@ -0,0 +1,178 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// minimalCatalogue is a valid catalogue in which every vocation only knows how to step
+var minimalCatalogue = `{
+	"version": 1,
+	"ranges": {"orthogonal": [[1, 0], [-1, 0], [0, 1], [0, -1]]},
+	"vocations": [
+		{"vocation": "VOCATION_SWORDSMAN", "base": {"health": 10}, "abilities": [{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}]},
+		{"vocation": "VOCATION_AXEMAN", "base": {"health": 10}, "abilities": [{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}]},
+		{"vocation": "VOCATION_LUMBERJACK", "base": {"health": 10}, "abilities": [{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}]},
+		{"vocation": "VOCATION_MINER", "base": {"health": 10}, "abilities": [{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}]},
+		{"vocation": "VOCATION_SUMMONER", "base": {"health": 10}, "abilities": [{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}]}
+	]
+}`
+
+var _ = Describe("Catalogue", func() {
+	Context("When the embedded catalogue is loaded", func() {
+		It("should contain every vocation", func() {
+			catalogue, err := LoadCatalogue()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, name := range []string{"VOCATION_SWORDSMAN", "VOCATION_AXEMAN", "VOCATION_LUMBERJACK", "VOCATION_MINER", "VOCATION_SUMMONER"} {
+				_, err := catalogue.GetVocation(name)
+				Expect(err).ToNot(HaveOccurred(), "expected vocation %s", name)
+			}
+		})
+
+		It("should refuse unknown vocations", func() {
+			catalogue, err := LoadCatalogue()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = catalogue.GetVocation("Jester")
+			Expect(err).To(MatchError(ErrUnknownVocation))
+		})
+	})
+
+	Context("When an invalid catalogue is parsed", func() {
+		It("should refuse an unsupported version", func() {
+			_, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, `"version": 1`, `"version": 2`, 1)))
+			Expect(err).To(MatchError(ErrUnsupportedVersion))
+		})
+
+		It("should refuse unknown fields", func() {
+			_, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, `"version": 1`, `"version": 1, "extra": true`, 1)))
+			Expect(err).To(MatchError(ErrInvalidCatalogue))
+		})
+
+		It("should refuse a catalogue that misses a vocation", func() {
+			_, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, `"VOCATION_MINER"`, `"VOCATION_SWORDSMAN"`, 1)))
+			Expect(err).To(MatchError(ErrDuplicateVocation))
+			Expect(err).To(MatchError(ErrMissingVocation))
+		})
+
+		It("should refuse abilities with an unknown kind or range", func() {
+			_, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, `{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}`, `{"kind": "fly", "name": "soar", "level": 1, "range": "sky"}`, 1)))
+			Expect(err).To(MatchError(ErrUnknownAbilityKind))
+			Expect(err).To(MatchError(ErrUnknownAbilityRange))
+		})
+
+		It("should refuse afflictions without a valid modifier", func() {
+			step := `{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}`
+
+			_, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, step, `{"kind": "afflict", "name": "hex", "level": 1, "range": "orthogonal"}`, 1)))
+			Expect(err).To(MatchError(ErrInvalidCatalogue))
+
+			_, err = ParseCatalogue([]byte(strings.Replace(minimalCatalogue, step,
+				`{"kind": "afflict", "name": "hex", "level": 1, "range": "orthogonal", "modifier": {"kind": "luck", "delta": 1, "duration_ticks": 0, "stacking": "sometimes"}}`, 1)))
+			Expect(err).To(MatchError(ErrUnknownModifierKind))
+			Expect(err).To(MatchError(ErrUnknownStacking))
+			Expect(err).To(MatchError(ErrInvalidCatalogue))
+		})
+
+		It("should refuse summons without health", func() {
+			_, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, `{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}`,
+				`{"kind": "summon", "name": "familiar", "level": 1, "range": "orthogonal"}`, 1)))
+			Expect(err).To(MatchError(ErrInvalidCatalogue))
+		})
+
+		It("should refuse vocations without health", func() {
+			_, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, `"health": 10`, `"health": 0`, 1)))
+			Expect(err).To(MatchError(ErrInvalidCatalogue))
+		})
+	})
+
+	Context("When a catalogue grants afflictions, dispels and summons", func() {
+		It("should equip combatants with their definitions", func() {
+			catalogue, err := ParseCatalogue([]byte(strings.Replace(minimalCatalogue, `"abilities": [{"kind": "move", "name": "step", "level": 1, "range": "orthogonal"}]`, `"abilities": [
+				{"kind": "afflict", "name": "hex", "level": 1, "range": "orthogonal", "modifier": {"kind": "attack_power", "delta": -2, "duration_ticks": 4, "stacking": "stack"}},
+				{"kind": "dispel", "name": "cleanse", "level": 1, "range": "orthogonal"},
+				{"kind": "summon", "name": "familiar", "level": 1, "health_percentage": 40, "attack_power_percentage": 60, "range": "orthogonal"}
+			]`, 1)))
+			Expect(err).ToNot(HaveOccurred())
+
+			vocation, err := catalogue.GetVocation("VOCATION_SWORDSMAN")
+			Expect(err).ToNot(HaveOccurred())
+
+			combatant := &Combatant{}
+			vocation.Equip(combatant, 1)
+
+			Expect(combatant.Definitions.Afflicts).To(HaveLen(1))
+			Expect(combatant.Definitions.Afflicts[0].Modifier).To(Equal(ModifierDefinition{Kind: ModifierAttackPower, Delta: -2, DurationTicks: 4, Stacking: StackingStack}))
+			Expect(combatant.Definitions.Dispels).To(HaveLen(1))
+			Expect(combatant.Definitions.Summons).To(HaveLen(1))
+			Expect(combatant.Definitions.Summons[0].HealthPercentage).To(Equal(int32(40)))
+			Expect(combatant.Definitions.Summons[0].AttackPowerPercentage).To(Equal(int32(60)))
+			Expect(combatant.Definitions.Summons[0].Range).To(HaveLen(4))
+		})
+
+		It("should let the summoner of the embedded catalogue summon", func() {
+			catalogue, err := LoadCatalogue()
+			Expect(err).ToNot(HaveOccurred())
+
+			vocation, err := catalogue.GetVocation("VOCATION_SUMMONER")
+			Expect(err).ToNot(HaveOccurred())
+
+			summoner := &Combatant{UnitId: "summoner", Team: sharedDomain.Team_TEAM_PLAYER}
+			vocation.Equip(summoner, 1)
+			Expect(summoner.Definitions.Summons).To(HaveLen(1))
+
+			enemy := &Combatant{UnitId: "enemy", Team: sharedDomain.Team_TEAM_OPPONENT}
+			vocation.Equip(enemy, 1)
+			enemy.Place(0, 0)
+
+			battle := NewBattle(1, []*Combatant{summoner, enemy})
+			for battle.GetTick() < vocation.Base.TicksBetweenSummoning+1 && battle.Tick() {
+			}
+
+			Expect(battle.GetCombatants()).To(ContainElement(HaveField("SummonerId", "summoner")))
+		})
+	})
+
+	Context("When a combatant is equipped with a vocation", func() {
+		var vocation *Vocation
+
+		BeforeEach(func() {
+			catalogue, err := LoadCatalogue()
+			Expect(err).ToNot(HaveOccurred())
+
+			vocation, err = catalogue.GetVocation("VOCATION_SWORDSMAN")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should grow the stats with the level", func() {
+			combatant := &Combatant{}
+			vocation.Equip(combatant, 5)
+
+			Expect(combatant.State.Health).To(Equal(vocation.Base.Health + 4*vocation.PerLevel.Health))
+			Expect(combatant.State.AttackPower).To(Equal(vocation.Base.AttackPower + 4*vocation.PerLevel.AttackPower))
+			Expect(combatant.State.TicksBetweenAttacking).To(Equal(vocation.Base.TicksBetweenAttacking))
+		})
+
+		It("should treat levels below one as level one", func() {
+			combatant := &Combatant{}
+			vocation.Equip(combatant, 0)
+
+			Expect(combatant.State.Health).To(Equal(vocation.Base.Health))
+		})
+
+		It("should only grant the abilities unlocked at the level", func() {
+			novice, veteran := &Combatant{}, &Combatant{}
+			vocation.Equip(novice, 1)
+			vocation.Equip(veteran, 10)
+
+			Expect(novice.Definitions.Attacks).To(HaveLen(1))
+			Expect(veteran.Definitions.Attacks).To(HaveLen(2))
+			Expect(veteran.Definitions.Moves).To(HaveLen(1))
+			Expect(veteran.Definitions.Attacks[0].Range).ToNot(BeEmpty())
+		})
+	})
+})
@@ -4,11 +4,36 @@ import (
 	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
 )
 
-// Combatant represents a combat unit with all its properties
+// Combatant represents a combat unit with all its properties, summoned combatants refer to their summoner
 type Combatant struct {
 	UnitId        string
+	SummonerId    string
 	Team          sharedDomain.Team
 	Definitions   Definitions
 	State         State
 	Modifications Modifications
+	Cooldowns     Cooldowns
+}
+
+// IsAlive returns whether the combatant has any health left
+func (c *Combatant) IsAlive() bool {
+	return c.EffectiveHealth() > 0
+}
+
+// IsEnemyOf returns whether the other combatant fights for a different team
+func (c *Combatant) IsEnemyOf(other *Combatant) bool {
+	return c.Team != other.Team
+}
+
+// IsAt returns whether the combatant is located at the given coordinates
+func (c *Combatant) IsAt(x, y int32) bool {
+	coordinates := c.EffectiveCoordinates()
+	return coordinates.X == x && coordinates.Y == y
+}
+
+// MoveTo relocates the combatant so that its effective location matches the given coordinates
+func (c *Combatant) MoveTo(x, y int32) {
+	coordinates := c.EffectiveCoordinates()
+	c.State.Coordinates.X += x - coordinates.X
+	c.State.Coordinates.Y += y - coordinates.Y
 }
@@ -0,0 +1,41 @@
+package domain
+
+// Cooldowns contains the remaining ticks before each ability of a combatant can be used again
+type Cooldowns struct {
+	Dispelling int32
+	Afflicting int32
+	Attacking  int32
+	Moving     int32
+	Healing    int32
+	Summoning  int32
+}
+
+// NewCooldowns initializes the cooldowns with the effective intervals of the given combatant
+func NewCooldowns(combatant *Combatant) Cooldowns {
+	return Cooldowns{
+		Dispelling: combatant.EffectiveTicksBetweenDispelling(),
+		Afflicting: combatant.EffectiveTicksBetweenAfflicting(),
+		Attacking:  combatant.EffectiveTicksBetweenAttacking(),
+		Moving:     combatant.EffectiveTicksBetweenMoving(),
+		Healing:    combatant.EffectiveTicksBetweenHealing(),
+		Summoning:  combatant.EffectiveTicksBetweenSummoning(),
+	}
+}
+
+// Tick counts every cooldown down by a single tick
+func (c *Cooldowns) Tick() {
+	c.Dispelling = countDown(c.Dispelling)
+	c.Afflicting = countDown(c.Afflicting)
+	c.Attacking = countDown(c.Attacking)
+	c.Moving = countDown(c.Moving)
+	c.Healing = countDown(c.Healing)
+	c.Summoning = countDown(c.Summoning)
+}
+
+// countDown decrements the ticks without going below zero
+func countDown(ticks int32) int32 {
+	if ticks > 0 {
+		return ticks - 1
+	}
+	return 0
+}
@@ -4,7 +4,8 @@ import (
 	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
 )
 
-// Definitions contains all ability definitions for a combatant
+// Definitions contains all ability definitions for a combatant.
+// Ranges are axial offsets relative to the combatant, so they cover the same hexes from every column.
 type Definitions struct {
 	Dispels  []DispelDefinition
 	Afflicts []AfflictDefinition
@@ -14,6 +15,36 @@ type Definitions struct {
 	Summons  []SummonDefinition
 }
 
+// ModifierKind names the value of a combatant that a modifier changes
+type ModifierKind string
+
+// The kinds of modifiers an ability can apply
+const (
+	ModifierHealth                 ModifierKind = "health"
+	ModifierAttackPower            ModifierKind = "attack_power"
+	ModifierTicksBetweenAttacking  ModifierKind = "ticks_between_attacking"
+	ModifierTicksBetweenMoving     ModifierKind = "ticks_between_moving"
+	ModifierTicksBetweenDispelling ModifierKind = "ticks_between_dispelling"
+	ModifierTicksBetweenAfflicting ModifierKind = "ticks_between_afflicting"
+	ModifierTicksBetweenHealing    ModifierKind = "ticks_between_healing"
+	ModifierTicksBetweenSummoning  ModifierKind = "ticks_between_summoning"
+)
+
+// ModifierKinds contains every kind of modifier an ability can apply
+var ModifierKinds = []ModifierKind{
+	ModifierHealth, ModifierAttackPower,
+	ModifierTicksBetweenAttacking, ModifierTicksBetweenMoving, ModifierTicksBetweenDispelling,
+	ModifierTicksBetweenAfflicting, ModifierTicksBetweenHealing, ModifierTicksBetweenSummoning,
+}
+
+// ModifierDefinition contains all data needed to apply a modifier
+type ModifierDefinition struct {
+	Kind          ModifierKind
+	Delta         int32
+	DurationTicks int32
+	Stacking      StackingRule
+}
+
 // AttackDefinition contains all data needed to execute an attack
 type AttackDefinition struct {
 	Name                  string
@@ -33,20 +64,23 @@ type HealDefinition struct {
 	Range []sharedDomain.Coordinates
 }
 
-// AfflictDefinition contains all data needed to execute an affliction
+// AfflictDefinition contains all data needed to execute an affliction, the modifier is applied to an enemy
 type AfflictDefinition struct {
-	Name  string
-	Range []sharedDomain.Coordinates
+	Name     string
+	Range    []sharedDomain.Coordinates
+	Modifier ModifierDefinition
 }
 
-// DispelDefinition contains all data needed to execute a dispel
+// DispelDefinition contains all data needed to execute a dispel, which removes the harmful modifiers of an ally
 type DispelDefinition struct {
 	Name  string
 	Range []sharedDomain.Coordinates
 }
 
-// SummonDefinition contains all data needed to execute a summon
+// SummonDefinition contains all data needed to execute a summon, the summoned combatant gets a share of the summoner's health and attack power
 type SummonDefinition struct {
-	Name  string
-	Range []sharedDomain.Coordinates
+	Name                  string
+	Range                 []sharedDomain.Coordinates
+	HealthPercentage      int32
+	AttackPowerPercentage int32
 }
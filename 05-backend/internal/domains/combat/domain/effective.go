@@ -0,0 +1,87 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+)
+
+// EffectiveHealth returns the health of the combatant including its active modifiers
+func (c *Combatant) EffectiveHealth() int32 {
+	health := c.State.Health
+	for i := range c.Modifications.HealthModifiers {
+		health += c.Modifications.HealthModifiers[i].HealthDelta
+	}
+	return health
+}
+
+// EffectiveAttackPower returns the attack power of the combatant including its active modifiers
+func (c *Combatant) EffectiveAttackPower() int32 {
+	attackPower := c.State.AttackPower
+	for i := range c.Modifications.AttackPowerModifiers {
+		attackPower += c.Modifications.AttackPowerModifiers[i].AttackDelta
+	}
+	return max(attackPower, 0)
+}
+
+// EffectiveCoordinates returns the location of the combatant including its active modifiers
+func (c *Combatant) EffectiveCoordinates() *sharedDomain.Coordinates {
+	x, y := c.State.Coordinates.X, c.State.Coordinates.Y
+	for i := range c.Modifications.LocationModifiers {
+		x += c.Modifications.LocationModifiers[i].CoordinatesDelta.X
+		y += c.Modifications.LocationModifiers[i].CoordinatesDelta.Y
+	}
+	return sharedDomain.NewCoordinates(x, y)
+}
+
+// EffectiveTicksBetweenAttacking returns the ticks between attacks including the active modifiers
+func (c *Combatant) EffectiveTicksBetweenAttacking() int32 {
+	ticks := c.State.TicksBetweenAttacking
+	for i := range c.Modifications.TicksBetweenAttackingModifiers {
+		ticks += c.Modifications.TicksBetweenAttackingModifiers[i].TicksDelta
+	}
+	return max(ticks, 0)
+}
+
+// EffectiveTicksBetweenMoving returns the ticks between moves including the active modifiers
+func (c *Combatant) EffectiveTicksBetweenMoving() int32 {
+	ticks := c.State.TicksBetweenMoving
+	for i := range c.Modifications.TicksBetweenMovingModifiers {
+		ticks += c.Modifications.TicksBetweenMovingModifiers[i].TicksDelta
+	}
+	return max(ticks, 0)
+}
+
+// EffectiveTicksBetweenDispelling returns the ticks between dispels including the active modifiers
+func (c *Combatant) EffectiveTicksBetweenDispelling() int32 {
+	ticks := c.State.TicksBetweenDispelling
+	for i := range c.Modifications.TicksBetweenDispellingModifiers {
+		ticks += c.Modifications.TicksBetweenDispellingModifiers[i].TicksDelta
+	}
+	return max(ticks, 0)
+}
+
+// EffectiveTicksBetweenAfflicting returns the ticks between afflictions including the active modifiers
+func (c *Combatant) EffectiveTicksBetweenAfflicting() int32 {
+	ticks := c.State.TicksBetweenAfflicting
+	for i := range c.Modifications.TicksBetweenAfflictingModifiers {
+		ticks += c.Modifications.TicksBetweenAfflictingModifiers[i].TicksDelta
+	}
+	return max(ticks, 0)
+}
+
+// EffectiveTicksBetweenHealing returns the ticks between heals including the active modifiers
+func (c *Combatant) EffectiveTicksBetweenHealing() int32 {
+	ticks := c.State.TicksBetweenHealing
+	for i := range c.Modifications.TicksBetweenHealingModifiers {
+		ticks += c.Modifications.TicksBetweenHealingModifiers[i].TicksDelta
+	}
+	return max(ticks, 0)
+}
+
+// EffectiveTicksBetweenSummoning returns the ticks between summons including the active modifiers
+func (c *Combatant) EffectiveTicksBetweenSummoning() int32 {
+	ticks := c.State.TicksBetweenSummoning
+	for i := range c.Modifications.TicksBetweenSummoningModifiers {
+		ticks += c.Modifications.TicksBetweenSummoningModifiers[i].TicksDelta
+	}
+	return max(ticks, 0)
+}
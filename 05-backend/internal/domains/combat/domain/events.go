@@ -1 +1,40 @@
 package domain
+
+import (
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/events"
+)
+
+// The types of the events raised by the combat domain
+const (
+	CombatantDiedEvent = "combat.combatant_died"
+)
+
+// CombatantDied is raised for every combatant that died during a battle
+type CombatantDied struct {
+	events.Base
+	BattleId string
+	UnitId   string
+	KillerId string
+	Tick     int32
+}
+
+// NewCombatantDied creates the event raised for the death recorded in the log of the battle
+func NewCombatantDied(battleId string, death Action) *CombatantDied {
+	return &CombatantDied{Base: events.NewBase(battleId), BattleId: battleId, UnitId: death.TargetId, KillerId: death.ActorId, Tick: death.Tick}
+}
+
+// Type implements events.Event
+func (e *CombatantDied) Type() string { return CombatantDiedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *CombatantDied) Data() any {
+	return &CombatantDiedPayload{BattleId: e.BattleId, UnitId: e.UnitId, KillerId: e.KillerId, Tick: e.Tick}
+}
+
+// RegisterEvents registers the payloads of the events of the domain
+func RegisterEvents(registry *events.Registry) error {
+	return errors.Join(
+		registry.Register(CombatantDiedEvent, 1, &CombatantDiedPayload{}),
+	)
+}
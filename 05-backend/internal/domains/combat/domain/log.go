@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ActionType describes what happened during a tick of a battle
+type ActionType string
+
+// The actions a battle records in its log
+const (
+	ActionMove            ActionType = "move"
+	ActionAttack          ActionType = "attack"
+	ActionHeal            ActionType = "heal"
+	ActionModifierApplied ActionType = "modifier_applied"
+	ActionDispel          ActionType = "dispel"
+	ActionSummon          ActionType = "summon"
+	ActionDeath           ActionType = "death"
+)
+
+// Action is a single entry in the log of a battle
+type Action struct {
+	Tick     int32      `json:"tick"`
+	Type     ActionType `json:"type"`
+	ActorId  string     `json:"actor_id,omitempty"`
+	TargetId string     `json:"target_id,omitempty"`
+	Name     string     `json:"name,omitempty"`
+	Amount   int32      `json:"amount,omitempty"`
+	X        int32      `json:"x"`
+	Y        int32      `json:"y"`
+}
+
+// MarshalCombatants serializes the combatants, including their state and active modifiers
+func MarshalCombatants(combatants []*Combatant) ([]byte, error) {
+	data, err := json.Marshal(combatants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal combatants: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalCombatants deserializes combatants that were serialized with MarshalCombatants
+func UnmarshalCombatants(data []byte) ([]*Combatant, error) {
+	var combatants []*Combatant
+	if err := json.Unmarshal(data, &combatants); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal combatants: %w", err)
+	}
+	return combatants, nil
+}
+
+// CloneCombatants returns deep copies of the combatants, so that a battle can be fought without altering them
+func CloneCombatants(combatants []*Combatant) ([]*Combatant, error) {
+	data, err := MarshalCombatants(combatants)
+	if err != nil {
+		return nil, err
+	}
+	return UnmarshalCombatants(data)
+}
@@ -17,6 +17,138 @@ type Modifications struct {
 	TicksBetweenSummoningModifiers  []TicksBetweenSummoningModifier
 }
 
+// AddHealthModifier applies a health modifier according to the stacking rule
+func (m *Modifications) AddHealthModifier(modifier *HealthModifier, rule StackingRule) {
+	m.HealthModifiers = addModifier(m.HealthModifiers, modifier, rule)
+}
+
+// AddAttackPowerModifier applies an attack power modifier according to the stacking rule
+func (m *Modifications) AddAttackPowerModifier(modifier *AttackPowerModifier, rule StackingRule) {
+	m.AttackPowerModifiers = addModifier(m.AttackPowerModifiers, modifier, rule)
+}
+
+// AddLocationModifier applies a location modifier according to the stacking rule
+func (m *Modifications) AddLocationModifier(modifier *LocationModifier, rule StackingRule) {
+	m.LocationModifiers = addModifier(m.LocationModifiers, modifier, rule)
+}
+
+// AddTicksBetweenAttackingModifier applies an attack speed modifier according to the stacking rule
+func (m *Modifications) AddTicksBetweenAttackingModifier(modifier *TicksBetweenAttackingModifier, rule StackingRule) {
+	m.TicksBetweenAttackingModifiers = addModifier(m.TicksBetweenAttackingModifiers, modifier, rule)
+}
+
+// AddTicksBetweenMovingModifier applies a movement speed modifier according to the stacking rule
+func (m *Modifications) AddTicksBetweenMovingModifier(modifier *TicksBetweenMovingModifier, rule StackingRule) {
+	m.TicksBetweenMovingModifiers = addModifier(m.TicksBetweenMovingModifiers, modifier, rule)
+}
+
+// AddTicksBetweenDispellingModifier applies a dispelling speed modifier according to the stacking rule
+func (m *Modifications) AddTicksBetweenDispellingModifier(modifier *TicksBetweenDispellingModifier, rule StackingRule) {
+	m.TicksBetweenDispellingModifiers = addModifier(m.TicksBetweenDispellingModifiers, modifier, rule)
+}
+
+// AddTicksBetweenAfflictingModifier applies an afflicting speed modifier according to the stacking rule
+func (m *Modifications) AddTicksBetweenAfflictingModifier(modifier *TicksBetweenAfflictingModifier, rule StackingRule) {
+	m.TicksBetweenAfflictingModifiers = addModifier(m.TicksBetweenAfflictingModifiers, modifier, rule)
+}
+
+// AddTicksBetweenHealingModifier applies a healing speed modifier according to the stacking rule
+func (m *Modifications) AddTicksBetweenHealingModifier(modifier *TicksBetweenHealingModifier, rule StackingRule) {
+	m.TicksBetweenHealingModifiers = addModifier(m.TicksBetweenHealingModifiers, modifier, rule)
+}
+
+// AddTicksBetweenSummoningModifier applies a summoning speed modifier according to the stacking rule
+func (m *Modifications) AddTicksBetweenSummoningModifier(modifier *TicksBetweenSummoningModifier, rule StackingRule) {
+	m.TicksBetweenSummoningModifiers = addModifier(m.TicksBetweenSummoningModifiers, modifier, rule)
+}
+
+// Apply adds the modifier described by the definition under the given name, through the matching Add method
+func (m *Modifications) Apply(name string, definition *ModifierDefinition) {
+	switch definition.Kind {
+	case ModifierHealth:
+		m.AddHealthModifier(&HealthModifier{Name: name, HealthDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	case ModifierAttackPower:
+		m.AddAttackPowerModifier(&AttackPowerModifier{Name: name, AttackDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	case ModifierTicksBetweenAttacking:
+		m.AddTicksBetweenAttackingModifier(&TicksBetweenAttackingModifier{Name: name, TicksDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	case ModifierTicksBetweenMoving:
+		m.AddTicksBetweenMovingModifier(&TicksBetweenMovingModifier{Name: name, TicksDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	case ModifierTicksBetweenDispelling:
+		m.AddTicksBetweenDispellingModifier(&TicksBetweenDispellingModifier{Name: name, TicksDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	case ModifierTicksBetweenAfflicting:
+		m.AddTicksBetweenAfflictingModifier(&TicksBetweenAfflictingModifier{Name: name, TicksDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	case ModifierTicksBetweenHealing:
+		m.AddTicksBetweenHealingModifier(&TicksBetweenHealingModifier{Name: name, TicksDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	case ModifierTicksBetweenSummoning:
+		m.AddTicksBetweenSummoningModifier(&TicksBetweenSummoningModifier{Name: name, TicksDelta: definition.Delta, DurationTicks: definition.DurationTicks}, definition.Stacking)
+	}
+}
+
+// HasHarmful returns whether any active modifier weakens the combatant
+func (m *Modifications) HasHarmful() bool {
+	return containsHarmful(m.HealthModifiers) ||
+		containsHarmful(m.AttackPowerModifiers) ||
+		containsHarmful(m.LocationModifiers) ||
+		containsHarmful(m.TicksBetweenAttackingModifiers) ||
+		containsHarmful(m.TicksBetweenMovingModifiers) ||
+		containsHarmful(m.TicksBetweenDispellingModifiers) ||
+		containsHarmful(m.TicksBetweenAfflictingModifiers) ||
+		containsHarmful(m.TicksBetweenHealingModifiers) ||
+		containsHarmful(m.TicksBetweenSummoningModifiers)
+}
+
+// Dispel removes every modifier that weakens the combatant and returns the names of the removed modifiers
+func (m *Modifications) Dispel() []string {
+	var removed, names []string
+	m.HealthModifiers, names = dispelModifiers(m.HealthModifiers)
+	removed = append(removed, names...)
+	m.AttackPowerModifiers, names = dispelModifiers(m.AttackPowerModifiers)
+	removed = append(removed, names...)
+	m.LocationModifiers, names = dispelModifiers(m.LocationModifiers)
+	removed = append(removed, names...)
+	m.TicksBetweenAttackingModifiers, names = dispelModifiers(m.TicksBetweenAttackingModifiers)
+	removed = append(removed, names...)
+	m.TicksBetweenMovingModifiers, names = dispelModifiers(m.TicksBetweenMovingModifiers)
+	removed = append(removed, names...)
+	m.TicksBetweenDispellingModifiers, names = dispelModifiers(m.TicksBetweenDispellingModifiers)
+	removed = append(removed, names...)
+	m.TicksBetweenAfflictingModifiers, names = dispelModifiers(m.TicksBetweenAfflictingModifiers)
+	removed = append(removed, names...)
+	m.TicksBetweenHealingModifiers, names = dispelModifiers(m.TicksBetweenHealingModifiers)
+	removed = append(removed, names...)
+	m.TicksBetweenSummoningModifiers, names = dispelModifiers(m.TicksBetweenSummoningModifiers)
+	removed = append(removed, names...)
+	return removed
+}
+
+// Tick counts the duration of every active modifier down and removes the expired ones
+func (m *Modifications) Tick() {
+	m.HealthModifiers = tickModifiers(m.HealthModifiers)
+	m.AttackPowerModifiers = tickModifiers(m.AttackPowerModifiers)
+	m.LocationModifiers = tickModifiers(m.LocationModifiers)
+	m.TicksBetweenAttackingModifiers = tickModifiers(m.TicksBetweenAttackingModifiers)
+	m.TicksBetweenMovingModifiers = tickModifiers(m.TicksBetweenMovingModifiers)
+	m.TicksBetweenDispellingModifiers = tickModifiers(m.TicksBetweenDispellingModifiers)
+	m.TicksBetweenAfflictingModifiers = tickModifiers(m.TicksBetweenAfflictingModifiers)
+	m.TicksBetweenHealingModifiers = tickModifiers(m.TicksBetweenHealingModifiers)
+	m.TicksBetweenSummoningModifiers = tickModifiers(m.TicksBetweenSummoningModifiers)
+}
+
+// Names returns the names of all active modifiers
+func (m *Modifications) Names() []string {
+	var names []string
+	names = append(names, modifierNames(m.HealthModifiers)...)
+	names = append(names, modifierNames(m.AttackPowerModifiers)...)
+	names = append(names, modifierNames(m.LocationModifiers)...)
+	names = append(names, modifierNames(m.TicksBetweenAttackingModifiers)...)
+	names = append(names, modifierNames(m.TicksBetweenMovingModifiers)...)
+	names = append(names, modifierNames(m.TicksBetweenDispellingModifiers)...)
+	names = append(names, modifierNames(m.TicksBetweenAfflictingModifiers)...)
+	names = append(names, modifierNames(m.TicksBetweenHealingModifiers)...)
+	names = append(names, modifierNames(m.TicksBetweenSummoningModifiers)...)
+	return names
+}
+
 // HealthModifier represents a health modification effect
 type HealthModifier struct {
 	Name          string
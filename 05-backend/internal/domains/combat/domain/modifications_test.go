@@ -0,0 +1,118 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Modifications", func() {
+	var combatant *Combatant
+
+	BeforeEach(func() {
+		combatant = &Combatant{
+			State: State{
+				Health:                20,
+				AttackPower:           10,
+				Coordinates:           sharedDomain.Coordinates{X: 2, Y: 3},
+				TicksBetweenAttacking: 4,
+				TicksBetweenMoving:    2,
+			},
+		}
+	})
+
+	Context("When modifiers with the same name are applied", func() {
+		It("should replace the active modifier when refreshing", func() {
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 5, DurationTicks: 1}, StackingRefresh)
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 5, DurationTicks: 3}, StackingRefresh)
+
+			Expect(combatant.Modifications.AttackPowerModifiers).To(HaveLen(1))
+			Expect(combatant.Modifications.AttackPowerModifiers[0].DurationTicks).To(Equal(int32(3)))
+			Expect(combatant.EffectiveAttackPower()).To(Equal(int32(15)))
+		})
+
+		It("should add up the modifiers when stacking", func() {
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 5, DurationTicks: 3}, StackingStack)
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 5, DurationTicks: 3}, StackingStack)
+
+			Expect(combatant.Modifications.AttackPowerModifiers).To(HaveLen(2))
+			Expect(combatant.EffectiveAttackPower()).To(Equal(int32(20)))
+		})
+
+		It("should keep the active modifier when ignoring", func() {
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 5, DurationTicks: 1}, StackingIgnore)
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 8, DurationTicks: 3}, StackingIgnore)
+
+			Expect(combatant.Modifications.AttackPowerModifiers).To(HaveLen(1))
+			Expect(combatant.EffectiveAttackPower()).To(Equal(int32(15)))
+		})
+
+		It("should not affect modifiers with a different name", func() {
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 5, DurationTicks: 3}, StackingRefresh)
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "blessing", AttackDelta: 2, DurationTicks: 3}, StackingRefresh)
+
+			Expect(combatant.EffectiveAttackPower()).To(Equal(int32(17)))
+		})
+	})
+
+	Context("When modifiers tick down", func() {
+		It("should remove them once they expire", func() {
+			combatant.Modifications.AddHealthModifier(&HealthModifier{Name: "shield", HealthDelta: 10, DurationTicks: 2}, StackingRefresh)
+			combatant.Modifications.AddTicksBetweenMovingModifier(&TicksBetweenMovingModifier{Name: "haste", TicksDelta: -1, DurationTicks: 1}, StackingRefresh)
+
+			Expect(combatant.EffectiveHealth()).To(Equal(int32(30)))
+			Expect(combatant.EffectiveTicksBetweenMoving()).To(Equal(int32(1)))
+
+			combatant.Modifications.Tick()
+			Expect(combatant.EffectiveHealth()).To(Equal(int32(30)))
+			Expect(combatant.EffectiveTicksBetweenMoving()).To(Equal(int32(2)))
+
+			combatant.Modifications.Tick()
+			Expect(combatant.EffectiveHealth()).To(Equal(int32(20)))
+			Expect(combatant.Modifications.HealthModifiers).To(BeEmpty())
+		})
+	})
+
+	Context("When a modifier definition is applied", func() {
+		It("should add a modifier of the matching kind under the given name", func() {
+			combatant.Modifications.Apply("slow", &ModifierDefinition{Kind: ModifierTicksBetweenMoving, Delta: 2, DurationTicks: 3, Stacking: StackingRefresh})
+			combatant.Modifications.Apply("shield", &ModifierDefinition{Kind: ModifierHealth, Delta: 5, DurationTicks: 3, Stacking: StackingRefresh})
+
+			Expect(combatant.Modifications.Names()).To(Equal([]string{"shield", "slow"}))
+			Expect(combatant.EffectiveTicksBetweenMoving()).To(Equal(int32(4)))
+			Expect(combatant.EffectiveHealth()).To(Equal(int32(25)))
+		})
+	})
+
+	Context("When modifiers are dispelled", func() {
+		It("should remove the harmful modifiers and keep the others", func() {
+			combatant.Modifications.AddHealthModifier(&HealthModifier{Name: "poison", HealthDelta: -5, DurationTicks: 3}, StackingRefresh)
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 5, DurationTicks: 3}, StackingRefresh)
+			combatant.Modifications.AddTicksBetweenAttackingModifier(&TicksBetweenAttackingModifier{Name: "slow", TicksDelta: 2, DurationTicks: 3}, StackingRefresh)
+			Expect(combatant.Modifications.HasHarmful()).To(BeTrue())
+
+			Expect(combatant.Modifications.Dispel()).To(ConsistOf("poison", "slow"))
+			Expect(combatant.Modifications.Names()).To(Equal([]string{"rage"}))
+			Expect(combatant.Modifications.HasHarmful()).To(BeFalse())
+		})
+	})
+
+	Context("When effective values are computed", func() {
+		It("should apply location modifiers on top of the base location", func() {
+			combatant.Modifications.AddLocationModifier(&LocationModifier{Name: "knockback", CoordinatesDelta: sharedDomain.Coordinates{X: 1, Y: -1}, DurationTicks: 2}, StackingRefresh)
+
+			coordinates := combatant.EffectiveCoordinates()
+			Expect(coordinates.X).To(Equal(int32(3)))
+			Expect(coordinates.Y).To(Equal(int32(2)))
+		})
+
+		It("should never drop attack power or intervals below zero", func() {
+			combatant.Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "weakness", AttackDelta: -50, DurationTicks: 2}, StackingRefresh)
+			combatant.Modifications.AddTicksBetweenAttackingModifier(&TicksBetweenAttackingModifier{Name: "frenzy", TicksDelta: -10, DurationTicks: 2}, StackingRefresh)
+
+			Expect(combatant.EffectiveAttackPower()).To(BeZero())
+			Expect(combatant.EffectiveTicksBetweenAttacking()).To(BeZero())
+		})
+	})
+})
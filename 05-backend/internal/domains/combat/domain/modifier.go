@@ -0,0 +1,230 @@
+package domain
+
+// StackingRule determines what happens when a modifier is applied while one with the same name is active
+type StackingRule int
+
+const (
+	// StackingRefresh replaces the active modifiers with the same name, resetting their duration
+	StackingRefresh StackingRule = iota
+	// StackingStack adds the modifier next to the active modifiers with the same name
+	StackingStack
+	// StackingIgnore discards the modifier when one with the same name is already active
+	StackingIgnore
+)
+
+// modifier is implemented by every kind of modifier
+type modifier interface {
+	GetName() string
+	GetDurationTicks() int32
+	SetDurationTicks(ticks int32)
+	IsHarmful() bool
+}
+
+// addModifier applies the modifier to the active modifiers according to the stacking rule
+func addModifier[T any, P interface {
+	*T
+	modifier
+}](modifiers []T, added P, rule StackingRule) []T {
+	switch rule {
+	case StackingIgnore:
+		if containsModifier[T, P](modifiers, added.GetName()) {
+			return modifiers
+		}
+	case StackingRefresh:
+		modifiers = removeModifiers[T, P](modifiers, added.GetName())
+	}
+	return append(modifiers, *added)
+}
+
+// containsModifier returns whether a modifier with the given name is active
+func containsModifier[T any, P interface {
+	*T
+	modifier
+}](modifiers []T, name string) bool {
+	for i := range modifiers {
+		if P(&modifiers[i]).GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// removeModifiers removes all modifiers with the given name
+func removeModifiers[T any, P interface {
+	*T
+	modifier
+}](modifiers []T, name string) []T {
+	kept := modifiers[:0]
+	for i := range modifiers {
+		if P(&modifiers[i]).GetName() != name {
+			kept = append(kept, modifiers[i])
+		}
+	}
+	return kept
+}
+
+// modifierNames returns the names of the modifiers in the order they were applied
+func modifierNames[T any, P interface {
+	*T
+	modifier
+}](modifiers []T) []string {
+	names := make([]string, 0, len(modifiers))
+	for i := range modifiers {
+		names = append(names, P(&modifiers[i]).GetName())
+	}
+	return names
+}
+
+// tickModifiers counts the duration of every modifier down and removes the expired ones
+func tickModifiers[T any, P interface {
+	*T
+	modifier
+}](modifiers []T) []T {
+	kept := modifiers[:0]
+	for i := range modifiers {
+		current := P(&modifiers[i])
+		current.SetDurationTicks(current.GetDurationTicks() - 1)
+		if current.GetDurationTicks() > 0 {
+			kept = append(kept, modifiers[i])
+		}
+	}
+	return kept
+}
+
+// containsHarmful returns whether any of the modifiers weakens the combatant
+func containsHarmful[T any, P interface {
+	*T
+	modifier
+}](modifiers []T) bool {
+	for i := range modifiers {
+		if P(&modifiers[i]).IsHarmful() {
+			return true
+		}
+	}
+	return false
+}
+
+// dispelModifiers removes the harmful modifiers and returns the modifiers that remain and the names of the removed ones
+func dispelModifiers[T any, P interface {
+	*T
+	modifier
+}](modifiers []T) ([]T, []string) {
+	var removed []string
+	kept := modifiers[:0]
+	for i := range modifiers {
+		if current := P(&modifiers[i]); current.IsHarmful() {
+			removed = append(removed, current.GetName())
+			continue
+		}
+		kept = append(kept, modifiers[i])
+	}
+	return kept, removed
+}
+
+// GetName returns the name of the modifier
+func (m *HealthModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *HealthModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *HealthModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *HealthModifier) IsHarmful() bool { return m.HealthDelta < 0 }
+
+// GetName returns the name of the modifier
+func (m *AttackPowerModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *AttackPowerModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *AttackPowerModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *AttackPowerModifier) IsHarmful() bool { return m.AttackDelta < 0 }
+
+// GetName returns the name of the modifier
+func (m *LocationModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *LocationModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *LocationModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant, being displaced always does
+func (m *LocationModifier) IsHarmful() bool { return true }
+
+// GetName returns the name of the modifier
+func (m *TicksBetweenAttackingModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *TicksBetweenAttackingModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *TicksBetweenAttackingModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *TicksBetweenAttackingModifier) IsHarmful() bool { return m.TicksDelta > 0 }
+
+// GetName returns the name of the modifier
+func (m *TicksBetweenMovingModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *TicksBetweenMovingModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *TicksBetweenMovingModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *TicksBetweenMovingModifier) IsHarmful() bool { return m.TicksDelta > 0 }
+
+// GetName returns the name of the modifier
+func (m *TicksBetweenDispellingModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *TicksBetweenDispellingModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *TicksBetweenDispellingModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *TicksBetweenDispellingModifier) IsHarmful() bool { return m.TicksDelta > 0 }
+
+// GetName returns the name of the modifier
+func (m *TicksBetweenAfflictingModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *TicksBetweenAfflictingModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *TicksBetweenAfflictingModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *TicksBetweenAfflictingModifier) IsHarmful() bool { return m.TicksDelta > 0 }
+
+// GetName returns the name of the modifier
+func (m *TicksBetweenHealingModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *TicksBetweenHealingModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *TicksBetweenHealingModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *TicksBetweenHealingModifier) IsHarmful() bool { return m.TicksDelta > 0 }
+
+// GetName returns the name of the modifier
+func (m *TicksBetweenSummoningModifier) GetName() string { return m.Name }
+
+// GetDurationTicks returns the remaining duration of the modifier
+func (m *TicksBetweenSummoningModifier) GetDurationTicks() int32 { return m.DurationTicks }
+
+// SetDurationTicks sets the remaining duration of the modifier
+func (m *TicksBetweenSummoningModifier) SetDurationTicks(ticks int32) { m.DurationTicks = ticks }
+
+// IsHarmful returns whether the modifier weakens the combatant
+func (m *TicksBetweenSummoningModifier) IsHarmful() bool { return m.TicksDelta > 0 }
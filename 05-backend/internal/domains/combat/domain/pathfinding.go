@@ -0,0 +1,41 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+)
+
+// moveBudget is the number of steps a combatant takes with a single move
+const moveBudget int32 = 1
+
+// moveGraph lets the pathfinder traverse the battlefield with the offsets of a move, occupied positions are blocked
+type moveGraph struct {
+	battle     *Battle
+	definition *MoveDefinition
+}
+
+// newMoveGraph creates a new instance of moveGraph
+func newMoveGraph(battle *Battle, definition *MoveDefinition) *moveGraph {
+	return &moveGraph{battle: battle, definition: definition}
+}
+
+// Neighbours implements Graph.Neighbours
+func (g *moveGraph) Neighbours(position *sharedDomain.Coordinates) []*sharedDomain.Coordinates {
+	neighbours := make([]*sharedDomain.Coordinates, 0, len(g.definition.Range))
+	for i := range g.definition.Range {
+		neighbours = append(neighbours, offsetFrom(position, &g.definition.Range[i]))
+	}
+	return neighbours
+}
+
+// Cost implements Graph.Cost
+func (g *moveGraph) Cost(position *sharedDomain.Coordinates) int32 {
+	if g.battle.combatantAt(position.X, position.Y) != nil {
+		return -1
+	}
+	return 1
+}
+
+// Estimate implements Graph.Estimate
+func (g *moveGraph) Estimate(from, to *sharedDomain.Coordinates) int32 {
+	return 0
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+)
+
+// BattlefieldWidth is the number of columns on the battlefield, the teams start on opposite sides
+const BattlefieldWidth int32 = 16
+
+// Place puts the combatant on the battlefield at the formation position, mirrored for the opponent
+func (c *Combatant) Place(positionX, positionY int32) {
+	c.State.Coordinates.Y = positionY
+
+	switch c.Team {
+	case sharedDomain.Team_TEAM_OPPONENT:
+		c.State.Coordinates.X = BattlefieldWidth - 1 - positionX
+		c.State.Facing = sharedDomain.Direction_DIRECTION_WEST
+	default:
+		c.State.Coordinates.X = positionX
+		c.State.Facing = sharedDomain.Direction_DIRECTION_EAST
+	}
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Placement", func() {
+	Context("When a combatant is placed", func() {
+		It("should keep the formation position for the player", func() {
+			combatant := &Combatant{Team: sharedDomain.Team_TEAM_PLAYER}
+			combatant.Place(3, 2)
+
+			Expect(combatant.IsAt(3, 2)).To(BeTrue())
+			Expect(combatant.State.Facing).To(Equal(sharedDomain.Direction_DIRECTION_EAST))
+		})
+
+		It("should mirror the formation position for the opponent", func() {
+			combatant := &Combatant{Team: sharedDomain.Team_TEAM_OPPONENT}
+			combatant.Place(3, 2)
+
+			Expect(combatant.IsAt(BattlefieldWidth-4, 2)).To(BeTrue())
+			Expect(combatant.State.Facing).To(Equal(sharedDomain.Direction_DIRECTION_WEST))
+		})
+	})
+})
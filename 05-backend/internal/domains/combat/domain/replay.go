@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"fmt"
+)
+
+// Replay rebuilds the battle from the seed and the initial combatants, advanced up to the given tick.
+// The initial combatants are left untouched, a negative tick replays the battle until it has ended.
+func Replay(seed int64, initial []*Combatant, tick int32, options ...BattleOpt) (*Battle, error) {
+	combatants, err := CloneCombatants(initial)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone combatants for replay: %w", err)
+	}
+
+	battle := NewBattle(seed, combatants, options...)
+	for (tick < 0 || battle.GetTick() < tick) && battle.Tick() {
+	}
+
+	return battle, nil
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newTestSkirmish creates two small teams that start a few steps apart
+func newTestSkirmish() []*Combatant {
+	return []*Combatant{
+		newTestCombatant("a1", sharedDomain.Team_TEAM_PLAYER, 0, 0, 30, 6),
+		newTestCombatant("a2", sharedDomain.Team_TEAM_PLAYER, 0, 2, 25, 8),
+		newTestCombatant("b1", sharedDomain.Team_TEAM_OPPONENT, 4, 0, 30, 7),
+		newTestCombatant("b2", sharedDomain.Team_TEAM_OPPONENT, 4, 2, 20, 9),
+	}
+}
+
+var _ = Describe("Replay", func() {
+	Context("When a battle is fought", func() {
+		It("should log every action in tick order", func() {
+			battle := NewBattle(3, newTestSkirmish())
+			battle.Run()
+
+			log := battle.GetLog()
+			Expect(log).ToNot(BeEmpty())
+			for i := 1; i < len(log); i++ {
+				Expect(log[i].Tick).To(BeNumerically(">=", log[i-1].Tick))
+			}
+
+			types := make(map[ActionType]int)
+			for _, action := range log {
+				types[action.Type]++
+			}
+			Expect(types[ActionMove]).To(BeNumerically(">", 0))
+			Expect(types[ActionAttack]).To(BeNumerically(">", 0))
+			Expect(types[ActionDeath]).To(BeNumerically(">=", 2))
+		})
+
+		It("should log the modifiers the combatants start with", func() {
+			combatants := newTestSkirmish()
+			combatants[0].Modifications.AddAttackPowerModifier(&AttackPowerModifier{Name: "rage", AttackDelta: 2, DurationTicks: 5}, StackingRefresh)
+
+			log := NewBattle(3, combatants).GetLog()
+			Expect(log).To(HaveLen(1))
+			Expect(log[0]).To(Equal(Action{Tick: 0, Type: ActionModifierApplied, TargetId: "a1", Name: "rage", X: 0, Y: 0}))
+		})
+
+		It("should let healers restore wounded allies", func() {
+			healer := newTestCombatant("healer", sharedDomain.Team_TEAM_PLAYER, 0, 0, 10, 4)
+			healer.Definitions.Heals = []HealDefinition{{Name: "mend", Range: adjacent}}
+			wounded := newTestCombatant("wounded", sharedDomain.Team_TEAM_PLAYER, 1, 0, 5, 1)
+			wounded.State.MaxHealth = 8
+			wounded.Definitions.Moves = nil
+			enemy := newTestCombatant("enemy", sharedDomain.Team_TEAM_OPPONENT, 9, 9, 10, 1)
+
+			battle := NewBattle(1, []*Combatant{healer, wounded, enemy})
+			battle.Tick()
+
+			Expect(wounded.State.Health).To(Equal(int32(8)))
+			Expect(battle.GetLog()).To(ContainElement(Action{Tick: 1, Type: ActionHeal, ActorId: "healer", TargetId: "wounded", Name: "mend", Amount: 3, X: 1, Y: 0}))
+		})
+	})
+
+	Context("When a battle is replayed", func() {
+		It("should rebuild the exact state at every tick", func() {
+			initial := newTestSkirmish()
+			original := NewBattle(11, newTestSkirmish())
+
+			for original.Tick() {
+				replayed, err := Replay(11, initial, original.GetTick())
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(replayed.GetTick()).To(Equal(original.GetTick()))
+				Expect(replayed.GetLog()).To(Equal(original.GetLog()))
+				for i, combatant := range replayed.GetCombatants() {
+					expected := original.GetCombatants()[i]
+					Expect(combatant.State.Health).To(Equal(expected.State.Health))
+					Expect(combatant.IsAt(expected.State.Coordinates.X, expected.State.Coordinates.Y)).To(BeTrue())
+				}
+			}
+		})
+
+		It("should leave the initial combatants untouched", func() {
+			initial := newTestSkirmish()
+
+			replayed, err := Replay(11, initial, -1)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(replayed.IsFinished()).To(BeTrue())
+			Expect(initial[0].State.Health).To(Equal(int32(30)))
+			Expect(initial[0].IsAt(0, 0)).To(BeTrue())
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 // State represents the current state of a combatant
 type State struct {
 	Health      int32
+	MaxHealth   int32
 	AttackPower int32
 
 	Coordinates sharedDomain.Coordinates
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCombat(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Combat Unit Tests")
+}
@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/combat/application"
+	"shvdg/crazed-conquerer/internal/domains/combat/domain"
+	formationDomain "shvdg/crazed-conquerer/internal/domains/formation/domain"
+	formationInfra "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	unitInfra "shvdg/crazed-conquerer/internal/domains/unit/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Combatant Service", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var formationRepo *formationInfra.FormationRepositoryImpl
+	var unitRepo *unitInfra.UnitRepositoryImpl
+	var combatantService *application.CombatantService
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+		catalogue, err := domain.LoadCatalogue()
+		Expect(err).ToNot(HaveOccurred(), "failed to load vocation catalogue")
+
+		formationRepo = formationInfra.NewFormationRepositoryImpl(suite.Database)
+		unitRepo = unitInfra.NewUnitRepositoryImpl(suite.Database)
+		combatantService = application.NewCombatantService(catalogue, formationRepo, unitRepo)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When a formation is converted into combatants", func() {
+		var swordsman, summoner *unitDomain.UnitEntity
+		var formation *formationDomain.FormationEntity
+
+		BeforeAll(func() {
+			swordsman = unitDomain.NewUnitEntity().WithDefaults().WithVocation("VOCATION_SWORDSMAN").WithLevel("3").Build()
+			summoner = unitDomain.NewUnitEntity().WithDefaults().WithVocation("VOCATION_SUMMONER").WithLevel("1").Build()
+			err := unitRepo.Create(ctx, swordsman, summoner)
+			Expect(err).ToNot(HaveOccurred(), "failed to create units")
+
+			formation = formationDomain.NewFormationEntity().WithDefaults().
+				WithRows([]*formationDomain.FormationRowEntity{{Columns: []*formationDomain.FormationColumnEntity{
+					{PositionX: 4, PositionY: 1, UnitId: swordsman.GetId()},
+					{PositionX: 0, PositionY: 2, UnitId: summoner.GetId()},
+					{PositionX: 2, PositionY: 2},
+				}}}).
+				Build()
+			err = formationRepo.Create(ctx, formation)
+			Expect(err).ToNot(HaveOccurred(), "failed to create formation")
+		})
+
+		It("should create a combatant for every placed unit", func() {
+			combatants, err := combatantService.GetCombatants(ctx, formation.GetId(), sharedDomain.Team_TEAM_PLAYER)
+			Expect(err).ToNot(HaveOccurred(), "failed to get combatants")
+			Expect(combatants).To(HaveLen(2))
+
+			first := combatants[0]
+			Expect(first.UnitId).To(Equal(swordsman.GetId()))
+			Expect(first.Team).To(Equal(sharedDomain.Team_TEAM_PLAYER))
+			Expect(first.State.Health).To(Equal(int32(120)))
+			Expect(first.State.Coordinates.X).To(Equal(int32(4)))
+			Expect(first.State.Coordinates.Y).To(Equal(int32(1)))
+			Expect(first.Definitions.Attacks).ToNot(BeEmpty())
+		})
+
+		It("should mirror the positions for the opponent", func() {
+			combatants, err := combatantService.GetCombatants(ctx, formation.GetId(), sharedDomain.Team_TEAM_OPPONENT)
+			Expect(err).ToNot(HaveOccurred(), "failed to get combatants")
+
+			Expect(combatants[0].State.Coordinates.X).To(Equal(domain.BattlefieldWidth - 5))
+			Expect(combatants[0].State.Facing).To(Equal(sharedDomain.Direction_DIRECTION_WEST))
+		})
+	})
+
+	Context("When a formation contains a unit with an unknown vocation", func() {
+		It("should return an error", func() {
+			unit := unitDomain.NewUnitEntity().WithDefaults().WithVocation("Jester").Build()
+			err := unitRepo.Create(ctx, unit)
+			Expect(err).ToNot(HaveOccurred(), "failed to create unit")
+
+			formation := formationDomain.NewFormationEntity().WithDefaults().
+				WithRows([]*formationDomain.FormationRowEntity{{Columns: []*formationDomain.FormationColumnEntity{
+					{PositionX: 0, PositionY: 0, UnitId: unit.GetId()},
+				}}}).
+				Build()
+			err = formationRepo.Create(ctx, formation)
+			Expect(err).ToNot(HaveOccurred(), "failed to create formation")
+
+			_, err = combatantService.GetCombatants(ctx, formation.GetId(), sharedDomain.Team_TEAM_PLAYER)
+			Expect(err).To(MatchError(domain.ErrUnknownVocation))
+		})
+	})
+})
@@ -1 +1,116 @@
 package application
+
+import (
+	"context"
+	"fmt"
+	characterFormationDomain "shvdg/crazed-conquerer/internal/domains/character-formation/domain"
+	"shvdg/crazed-conquerer/internal/domains/formation/domain"
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CharacterOwners tells which characters belong to which users
+type CharacterOwners interface {
+	VerifyOwner(ctx context.Context, userId, characterId string) error
+	OwnsAny(ctx context.Context, userId string, characterIds ...string) (bool, error)
+}
+
+// FormationService handles formation-related operations on behalf of the user whose characters own the formations
+type FormationService struct {
+	formations          domain.FormationRepository
+	characterFormations characterFormationDomain.CharacterFormationRepository
+	owners              CharacterOwners
+}
+
+// NewFormationService instantiates a new FormationService instance
+func NewFormationService(formations domain.FormationRepository, characterFormations characterFormationDomain.CharacterFormationRepository, owners CharacterOwners) *FormationService {
+	return &FormationService{formations: formations, characterFormations: characterFormations, owners: owners}
+}
+
+// GetCharacterFormation returns the formation of the character once it is verified to belong to the user.
+// database.ErrNotFound is returned when the character has no formation.
+func (s *FormationService) GetCharacterFormation(ctx context.Context, userId, characterId string) (*domain.FormationEntity, error) {
+	if err := s.owners.VerifyOwner(ctx, userId, characterId); err != nil {
+		return nil, err
+	}
+
+	characterFormations, err := s.characterFormations.GetByCharacterId(ctx, characterId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get formation of character '%s': %w", characterId, err)
+	}
+	if len(characterFormations) == 0 {
+		return nil, fmt.Errorf("character '%s' has no formation: %w", characterId, database.ErrNotFound)
+	}
+
+	formation, err := s.formations.GetById(ctx, characterFormations[0].GetFormationId())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get formation of character '%s': %w", characterId, err)
+	}
+	return formation, nil
+}
+
+// CreateFormation creates the formation of the character placing the units in the rows, once the character is verified
+// to belong to the user. ErrFormationExists is returned when the character has a formation already, a PlacementError
+// for every column that breaks a placement rule, including units that do not belong to the character.
+func (s *FormationService) CreateFormation(ctx context.Context, userId, characterId string, rows []*domain.FormationRowEntity) (*domain.FormationEntity, error) {
+	if err := s.owners.VerifyOwner(ctx, userId, characterId); err != nil {
+		return nil, err
+	}
+
+	characterFormations, err := s.characterFormations.GetByCharacterId(ctx, characterId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get formation of character '%s': %w", characterId, err)
+	}
+	if len(characterFormations) > 0 {
+		return nil, domain.ErrFormationExists
+	}
+
+	now := converters.TimeToTimestamp(time.Now())
+	formation := &domain.FormationEntity{
+		Id:        uuid.NewString(),
+		Rows:      rows,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.formations.CreateForCharacter(ctx, characterId, formation); err != nil {
+		return nil, err
+	}
+	return formation, nil
+}
+
+// PlaceUnits replaces the rows of the formation once it is verified to belong to one of the characters of the user.
+// ErrFormationNotOwned is returned when it does not, a PlacementError for every column that breaks a placement rule.
+func (s *FormationService) PlaceUnits(ctx context.Context, userId, formationId string, rows []*domain.FormationRowEntity) (*domain.FormationEntity, error) {
+	characterFormations, err := s.characterFormations.GetByFormationId(ctx, formationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters of formation '%s': %w", formationId, err)
+	}
+
+	characterIds := make([]string, len(characterFormations))
+	for i, characterFormation := range characterFormations {
+		characterIds[i] = characterFormation.GetCharacterId()
+	}
+
+	owned, err := s.owners.OwnsAny(ctx, userId, characterIds...)
+	if err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, domain.ErrFormationNotOwned
+	}
+
+	formation, err := s.formations.GetById(ctx, formationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get formation '%s': %w", formationId, err)
+	}
+
+	formation.Rows = rows
+	if err := s.formations.Update(ctx, formation); err != nil {
+		return nil, err
+	}
+	return formation, nil
+}
@@ -2,19 +2,25 @@ package domain
 
 import (
 	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/faker"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 // FormationEntityBuilder helps build and configure a FormationEntity object.
 type FormationEntityBuilder struct {
 	formationEntity *FormationEntity
+	faker           *faker.Faker
 }
 
 // NewFormationEntity initializes a new FormationEntityBuilder with empty values.
 func NewFormationEntity() *FormationEntityBuilder {
-	return &FormationEntityBuilder{formationEntity: &FormationEntity{}}
+	return &FormationEntityBuilder{formationEntity: &FormationEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *FormationEntityBuilder) WithFaker(faker *faker.Faker) *FormationEntityBuilder {
+	b.faker = faker
+	return b
 }
 
 // WithId sets the slot of the formation entity.
@@ -25,7 +31,7 @@ func (b *FormationEntityBuilder) WithId(slot string) *FormationEntityBuilder {
 
 // WithRandomId sets a random slot for the formation entity.
 func (b *FormationEntityBuilder) WithRandomId() *FormationEntityBuilder {
-	b.formationEntity.Id = uuid.New().String()
+	b.formationEntity.Id = b.faker.UUID()
 	return b
 }
 
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned when a formation breaks one of the placement rules
+var (
+	ErrPositionOutOfBounds = errors.New("position is outside the formation grid")
+	ErrDuplicatePosition   = errors.New("position is used more than once")
+	ErrDuplicateUnit       = errors.New("unit is placed more than once")
+	ErrUnitNotOwned        = errors.New("unit does not belong to the character")
+)
+
+// Errors returned when a formation does not belong to the expected owner
+var (
+	ErrFormationNotOwned = errors.New("formation does not belong to the user")
+	ErrMissingCharacter  = errors.New("formation has no character to belong to")
+)
+
+// ErrFormationExists is returned when a formation is created for a character that has one already
+var ErrFormationExists = errors.New("character already has a formation")
+
+// PlacementError describes which column of a formation breaks a placement rule
+type PlacementError struct {
+	Err       error
+	PositionX int32
+	PositionY int32
+	UnitId    string
+}
+
+// Error implements error.Error
+func (e *PlacementError) Error() string {
+	return fmt.Sprintf("invalid placement of unit '%s' at %d,%d: %s", e.UnitId, e.PositionX, e.PositionY, e.Err)
+}
+
+// Unwrap returns the placement rule that was broken
+func (e *PlacementError) Unwrap() error {
+	return e.Err
+}
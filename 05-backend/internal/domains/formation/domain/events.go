@@ -1 +1,47 @@
 package domain
+
+import (
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/events"
+)
+
+// The types of the events raised by the formation domain
+const (
+	FormationChangedEvent = "formation.changed"
+)
+
+// FormationChanged is raised when a formation has been stored with new placements
+type FormationChanged struct {
+	events.Base
+	FormationId string
+	UnitIds     []string
+}
+
+// NewFormationChanged creates the event raised when the formation has been stored
+func NewFormationChanged(formation *FormationEntity) *FormationChanged {
+	unitIds := []string{}
+	for _, row := range formation.GetRows() {
+		for _, column := range row.GetColumns() {
+			if column.GetUnitId() != "" {
+				unitIds = append(unitIds, column.GetUnitId())
+			}
+		}
+	}
+
+	return &FormationChanged{Base: events.NewBase(formation.GetId()), FormationId: formation.GetId(), UnitIds: unitIds}
+}
+
+// Type implements events.Event
+func (e *FormationChanged) Type() string { return FormationChangedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *FormationChanged) Data() any {
+	return &FormationChangedPayload{FormationId: e.FormationId, UnitIds: e.UnitIds}
+}
+
+// RegisterEvents registers the payloads of the events of the domain
+func RegisterEvents(registry *events.Registry) error {
+	return errors.Join(
+		registry.Register(FormationChangedEvent, 1, &FormationChangedPayload{}),
+	)
+}
@@ -5,4 +5,6 @@ import "context"
 // FormationRepository representation of a formation repository
 type FormationRepository interface {
 	GetById(ctx context.Context, id string) (*FormationEntity, error)
+	CreateForCharacter(ctx context.Context, characterId string, entities ...*FormationEntity) error
+	Update(ctx context.Context, entities ...*FormationEntity) error
 }
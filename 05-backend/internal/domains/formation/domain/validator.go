@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+)
+
+// The size of the grid units are placed on within a formation
+const (
+	GridWidth  int32 = 5
+	GridHeight int32 = 5
+)
+
+// FormationValidator checks whether a formation follows the placement rules
+type FormationValidator struct {
+	width      int32
+	height     int32
+	ownedUnits map[string]bool
+}
+
+// FormationValidatorOpt configures the FormationValidator during initialization
+type FormationValidatorOpt func(*FormationValidator)
+
+// WithGridSize sets the number of columns and rows units can be placed on
+func WithGridSize(width, height int32) FormationValidatorOpt {
+	return func(v *FormationValidator) {
+		v.width = width
+		v.height = height
+	}
+}
+
+// WithOwnedUnits sets the units of the owning character, without it the ownership of units is not checked
+func WithOwnedUnits(unitIds ...string) FormationValidatorOpt {
+	return func(v *FormationValidator) {
+		v.ownedUnits = make(map[string]bool, len(unitIds))
+		for _, unitId := range unitIds {
+			v.ownedUnits[unitId] = true
+		}
+	}
+}
+
+// NewFormationValidator creates a new instance of FormationValidator
+func NewFormationValidator(options ...FormationValidatorOpt) *FormationValidator {
+	validator := &FormationValidator{
+		width:  GridWidth,
+		height: GridHeight,
+	}
+
+	for _, option := range options {
+		option(validator)
+	}
+
+	return validator
+}
+
+// Validate returns a PlacementError for every column that breaks a placement rule, joined into a single error
+func (v *FormationValidator) Validate(formation *FormationEntity) error {
+	var errs []error
+	positions := make(map[[2]int32]bool)
+	units := make(map[string]bool)
+
+	for _, row := range formation.GetRows() {
+		for _, column := range row.GetColumns() {
+			for _, err := range v.validateColumn(column, positions, units) {
+				errs = append(errs, &PlacementError{
+					Err:       err,
+					PositionX: column.GetPositionX(),
+					PositionY: column.GetPositionY(),
+					UnitId:    column.GetUnitId(),
+				})
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateColumn returns the placement rules broken by the column, an empty unit id marks an empty slot
+func (v *FormationValidator) validateColumn(column *FormationColumnEntity, positions map[[2]int32]bool, units map[string]bool) []error {
+	var errs []error
+
+	x, y := column.GetPositionX(), column.GetPositionY()
+	if x < 0 || y < 0 || x >= v.width || y >= v.height {
+		errs = append(errs, ErrPositionOutOfBounds)
+	}
+
+	position := [2]int32{x, y}
+	if positions[position] {
+		errs = append(errs, ErrDuplicatePosition)
+	}
+	positions[position] = true
+
+	unitId := column.GetUnitId()
+	if unitId == "" {
+		return errs
+	}
+
+	if units[unitId] {
+		errs = append(errs, ErrDuplicateUnit)
+	}
+	units[unitId] = true
+
+	if v.ownedUnits != nil && !v.ownedUnits[unitId] {
+		errs = append(errs, ErrUnitNotOwned)
+	}
+
+	return errs
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newTestFormation creates a formation with a single row containing the given columns
+func newTestFormation(columns ...*FormationColumnEntity) *FormationEntity {
+	return NewFormationEntity().WithDefaults().
+		WithRows([]*FormationRowEntity{{Columns: columns}}).
+		Build()
+}
+
+var _ = Describe("Formation Validator", func() {
+	var validator *FormationValidator
+
+	BeforeEach(func() {
+		validator = NewFormationValidator()
+	})
+
+	Context("When the formation follows every placement rule", func() {
+		It("should not return an error", func() {
+			formation := newTestFormation(
+				&FormationColumnEntity{PositionX: 0, PositionY: 0, UnitId: "unit_1"},
+				&FormationColumnEntity{PositionX: 4, PositionY: 4, UnitId: "unit_2"},
+				&FormationColumnEntity{PositionX: 2, PositionY: 2},
+			)
+			Expect(validator.Validate(formation)).To(Succeed())
+		})
+
+		It("should accept an empty formation", func() {
+			Expect(validator.Validate(NewFormationEntity().WithDefaults().Build())).To(Succeed())
+		})
+	})
+
+	Context("When the formation breaks a placement rule", func() {
+		It("should refuse positions outside the grid", func() {
+			formation := newTestFormation(&FormationColumnEntity{PositionX: GridWidth, PositionY: 0, UnitId: "unit_1"})
+			Expect(validator.Validate(formation)).To(MatchError(ErrPositionOutOfBounds))
+
+			formation = newTestFormation(&FormationColumnEntity{PositionX: 0, PositionY: -1, UnitId: "unit_1"})
+			Expect(validator.Validate(formation)).To(MatchError(ErrPositionOutOfBounds))
+		})
+
+		It("should refuse positions that are used more than once", func() {
+			formation := newTestFormation(
+				&FormationColumnEntity{PositionX: 1, PositionY: 1, UnitId: "unit_1"},
+				&FormationColumnEntity{PositionX: 1, PositionY: 1, UnitId: "unit_2"},
+			)
+			Expect(validator.Validate(formation)).To(MatchError(ErrDuplicatePosition))
+		})
+
+		It("should refuse units that are placed more than once", func() {
+			formation := newTestFormation(
+				&FormationColumnEntity{PositionX: 0, PositionY: 0, UnitId: "unit_1"},
+				&FormationColumnEntity{PositionX: 1, PositionY: 0, UnitId: "unit_1"},
+			)
+			Expect(validator.Validate(formation)).To(MatchError(ErrDuplicateUnit))
+		})
+
+		It("should refuse units that do not belong to the character", func() {
+			validator = NewFormationValidator(WithOwnedUnits("unit_1"))
+			formation := newTestFormation(
+				&FormationColumnEntity{PositionX: 0, PositionY: 0, UnitId: "unit_1"},
+				&FormationColumnEntity{PositionX: 1, PositionY: 0, UnitId: "unit_2"},
+			)
+			Expect(validator.Validate(formation)).To(MatchError(ErrUnitNotOwned))
+		})
+
+		It("should describe every broken rule with the offending column", func() {
+			validator = NewFormationValidator(WithGridSize(2, 2), WithOwnedUnits())
+			formation := newTestFormation(&FormationColumnEntity{PositionX: 3, PositionY: 0, UnitId: "unit_1"})
+
+			err := validator.Validate(formation)
+			Expect(err).To(MatchError(ErrPositionOutOfBounds))
+			Expect(err).To(MatchError(ErrUnitNotOwned))
+
+			var placementErr *PlacementError
+			Expect(errors.As(err, &placementErr)).To(BeTrue())
+			Expect(placementErr.PositionX).To(Equal(int32(3)))
+			Expect(placementErr.UnitId).To(Equal("unit_1"))
+		})
+	})
+})
@@ -4,19 +4,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	characterformationinfra "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
+	characterunitinfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
 	"shvdg/crazed-conquerer/internal/domains/formation/domain"
 	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
 	"shvdg/crazed-conquerer/internal/shared/sql"
 )
 
 // FormationRepositoryImpl provides the concrete implementation of the FormationRepository interface
 type FormationRepositoryImpl struct {
 	database.Connection
+	recorder events.Recorder
 }
 
-// NewFormationRepositoryImpl creates a new instance of FormationRepositoryImpl
-func NewFormationRepositoryImpl(connection database.Connection) *FormationRepositoryImpl {
-	return &FormationRepositoryImpl{connection}
+// NewFormationRepositoryImpl creates a new instance of FormationRepositoryImpl, the recorders receive the events it raises
+func NewFormationRepositoryImpl(connection database.Connection, recorders ...events.Recorder) *FormationRepositoryImpl {
+	return &FormationRepositoryImpl{Connection: connection, recorder: events.Recorders(recorders)}
 }
 
 // GetById retrieves a formation by its id
@@ -52,7 +56,7 @@ func (s *FormationRepositoryImpl) Create(ctx context.Context, entities ...*domai
 		BatchValues(argSets).
 		BuildBatch()
 
-	return database.Batch(ctx, s.Connection, query, batchArgs)
+	return s.write(ctx, entities, query, batchArgs)
 }
 
 // Update updates one or more formation entities in the database
@@ -77,7 +81,7 @@ func (s *FormationRepositoryImpl) Update(ctx context.Context, entities ...*domai
 		Where(FieldId).
 		BuildBatch()
 
-	return database.Batch(ctx, s.Connection, query, batchArgs)
+	return s.write(ctx, entities, query, batchArgs)
 }
 
 // Upsert upserts one or more formation entities in the database
@@ -102,7 +106,19 @@ func (s *FormationRepositoryImpl) Upsert(ctx context.Context, entities ...*domai
 		BatchUpsert(argSets, []string{FieldId}, FieldRows).
 		BuildBatch()
 
-	return database.Batch(ctx, s.Connection, query, batchArgs)
+	return s.write(ctx, entities, query, batchArgs)
+}
+
+// CreateForCharacter inserts the formations and assigns them to the character in a single transaction.
+// Formations placing units that do not belong to the character are refused with ErrUnitNotOwned.
+func (s *FormationRepositoryImpl) CreateForCharacter(ctx context.Context, characterId string, entities ...*domain.FormationEntity) error {
+	return s.storeForCharacter(ctx, characterId, entities, s.Create)
+}
+
+// UpsertForCharacter upserts the formations and assigns them to the character in a single transaction.
+// Formations placing units that do not belong to the character are refused with ErrUnitNotOwned.
+func (s *FormationRepositoryImpl) UpsertForCharacter(ctx context.Context, characterId string, entities ...*domain.FormationEntity) error {
+	return s.storeForCharacter(ctx, characterId, entities, s.Upsert)
 }
 
 // Delete removes one or more formation entities from the database
@@ -124,6 +140,135 @@ func (s *FormationRepositoryImpl) Delete(ctx context.Context, entities ...*domai
 	return database.Execute(ctx, s.Connection, query, args...)
 }
 
+// write validates the formations and runs the query in a single transaction, so the units read for validation stay
+// assigned until the formations are stored
+func (s *FormationRepositoryImpl) write(ctx context.Context, entities []*domain.FormationEntity, query string, batchArgs [][]any) error {
+	return database.InTransaction(ctx, s.Connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		for _, entity := range entities {
+			if err := s.validate(ctx, entity); err != nil {
+				return err
+			}
+		}
+
+		if err := database.Batch(ctx, s.Connection, query, batchArgs); err != nil {
+			return err
+		}
+
+		raised := make([]events.Event, len(entities))
+		for i, entity := range entities {
+			raised[i] = domain.NewFormationChanged(entity)
+		}
+		return s.recorder.Record(ctx, raised...)
+	})
+}
+
+// storeForCharacter validates the formations against the units of the character, stores them and links them to it
+func (s *FormationRepositoryImpl) storeForCharacter(ctx context.Context, characterId string, entities []*domain.FormationEntity,
+	store func(ctx context.Context, entities ...*domain.FormationEntity) error) error {
+	if len(entities) == 0 {
+		return nil
+	}
+	if characterId == "" {
+		return fmt.Errorf("failed to store formations: %w", domain.ErrMissingCharacter)
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		argSets[i] = []any{characterId, entity.GetId()}
+	}
+
+	query, batchArgs := sql.NewQuery().
+		InsertInto(characterformationinfra.TableName).
+		InsertFields(characterformationinfra.FieldCharacterId, characterformationinfra.FieldFormationId).
+		BatchValues(argSets).
+		OnConflict(characterformationinfra.FieldCharacterId, characterformationinfra.FieldFormationId).
+		DoNothing().
+		BuildBatch()
+
+	return database.InTransaction(ctx, s.Connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		unitIds, err := s.getUnitIds(ctx, characterId)
+		if err != nil {
+			return err
+		}
+
+		validator := domain.NewFormationValidator(domain.WithOwnedUnits(unitIds...))
+		for _, entity := range entities {
+			if err := validator.Validate(entity); err != nil {
+				return fmt.Errorf("failed to validate formation '%s': %w", entity.GetId(), err)
+			}
+		}
+
+		if err := store(ctx, entities...); err != nil {
+			return err
+		}
+		return database.Batch(ctx, s.Connection, query, batchArgs)
+	})
+}
+
+// validate checks the formation against the placement rules, unit ownership is checked once the formation belongs to a character
+func (s *FormationRepositoryImpl) validate(ctx context.Context, entity *domain.FormationEntity) error {
+	var options []domain.FormationValidatorOpt
+
+	unitIds, owned, err := s.getOwnedUnitIds(ctx, entity.GetId())
+	if err != nil {
+		return err
+	}
+	if owned {
+		options = append(options, domain.WithOwnedUnits(unitIds...))
+	}
+
+	if err := domain.NewFormationValidator(options...).Validate(entity); err != nil {
+		return fmt.Errorf("failed to validate formation '%s': %w", entity.GetId(), err)
+	}
+
+	return nil
+}
+
+// getOwnedUnitIds retrieves the ids of the units belonging to the characters that own the formation, locking the links read
+func (s *FormationRepositoryImpl) getOwnedUnitIds(ctx context.Context, formationId string) ([]string, bool, error) {
+	query, args := sql.NewQuery().
+		Select(characterformationinfra.FieldCharacterId).
+		From(characterformationinfra.TableName).
+		Where(characterformationinfra.FieldFormationId, formationId).
+		ForShare().
+		Build()
+
+	characterIds, err := database.QueryMany(ctx, s.Connection, query, args, database.ScanString)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get characters of formation: %w", err)
+	}
+	if len(characterIds) == 0 {
+		return nil, false, nil
+	}
+
+	unitIds, err := s.getUnitIds(ctx, characterIds...)
+	if err != nil {
+		return nil, false, err
+	}
+	return unitIds, true, nil
+}
+
+// getUnitIds retrieves the ids of the units belonging to the characters, locking the links read
+func (s *FormationRepositoryImpl) getUnitIds(ctx context.Context, characterIds ...string) ([]string, error) {
+	ids := make([]any, len(characterIds))
+	for i, characterId := range characterIds {
+		ids[i] = characterId
+	}
+
+	query, args := sql.NewQuery().
+		Select(characterunitinfra.FieldUnitId).
+		From(characterunitinfra.TableName).
+		WhereIn(characterunitinfra.FieldCharacterId, ids...).
+		ForShare().
+		Build()
+
+	unitIds, err := database.QueryMany(ctx, s.Connection, query, args, database.ScanString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get units of characters: %w", err)
+	}
+	return unitIds, nil
+}
+
 // ReadOne executes a query and returns a single formation entity
 func (s *FormationRepositoryImpl) ReadOne(ctx context.Context, query string, values []any, scan database.ScannerFunc[*domain.FormationEntity]) (*domain.FormationEntity, error) {
 	return database.QueryOne(ctx, s.Connection, query, values, scan)
@@ -25,3 +25,13 @@ var mediumRowsJson = []byte(`[
 					]
 				}
 			]`)
+
+// duplicatePositionRowsJson is a JSON sample for rows that place two units on the same position.
+var duplicatePositionRowsJson = []byte(`[
+				{
+					"columns": [
+						{"position_x": 0, "position_y": 0, "unit_id": "unit_1"},
+						{"position_x": 0, "position_y": 0, "unit_id": "unit_2"}
+					]
+				}
+			]`)
@@ -2,8 +2,16 @@ package integration
 
 import (
 	"context"
+	characterFormationDomain "shvdg/crazed-conquerer/internal/domains/character-formation/domain"
+	characterFormationInfra "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
+	characterUnitDomain "shvdg/crazed-conquerer/internal/domains/character-unit/domain"
+	characterUnitInfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
+	characterDomain "shvdg/crazed-conquerer/internal/domains/character/domain"
+	characterInfra "shvdg/crazed-conquerer/internal/domains/character/infrastructure"
 	"shvdg/crazed-conquerer/internal/domains/formation/domain"
 	infra "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	unitInfra "shvdg/crazed-conquerer/internal/domains/unit/infrastructure"
 	"shvdg/crazed-conquerer/internal/shared/contexts"
 	"shvdg/crazed-conquerer/internal/shared/database"
 	"shvdg/crazed-conquerer/internal/shared/sql"
@@ -21,6 +29,7 @@ var _ = Describe("Formation Repository", Ordered, func() {
 	var ctx context.Context
 
 	var suite *testing.Suite
+	var recorder *testing.EventRecorder
 	var formationRepo *infra.FormationRepositoryImpl
 
 	BeforeAll(func() {
@@ -29,7 +38,8 @@ var _ = Describe("Formation Repository", Ordered, func() {
 		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
 
 		ctx = contexts.SetTransaction(suite.Context, transaction)
-		formationRepo = infra.NewFormationRepositoryImpl(suite.Database)
+		recorder = testing.NewEventRecorder()
+		formationRepo = infra.NewFormationRepositoryImpl(suite.Database, recorder)
 	})
 
 	AfterAll(func() {
@@ -56,6 +66,12 @@ var _ = Describe("Formation Repository", Ordered, func() {
 			Expect(err).ToNot(HaveOccurred(), "failed to count formations")
 			Expect(count).To(Equal(1), "expected 1 formation to be created")
 		})
+
+		It("should raise a FormationChanged event", func() {
+			raised := recorder.OfType(domain.FormationChangedEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 FormationChanged event")
+			Expect(raised[0].AggregateID()).To(Equal(formation.GetId()))
+		})
 	})
 
 	Context("When retrieving formation by ID", func() {
@@ -137,6 +153,15 @@ var _ = Describe("Formation Repository", Ordered, func() {
 			Expect(secondRow[1].GetPositionY()).To(Equal(int32(1)))
 			Expect(secondRow[1].GetUnitId()).To(Equal("unit_5"))
 		})
+
+		It("should raise a FormationChanged event with the new placements", func() {
+			raised := recorder.OfType(domain.FormationChangedEvent)
+			Expect(raised).ToNot(BeEmpty(), "expected FormationChanged events")
+
+			changed := raised[len(raised)-1].(*domain.FormationChanged)
+			Expect(changed.FormationId).To(Equal("update-test-123"))
+			Expect(changed.UnitIds).To(HaveLen(4))
+		})
 	})
 
 	Context("When one formation is upserted", func() {
@@ -195,4 +220,156 @@ var _ = Describe("Formation Repository", Ordered, func() {
 			Expect(count).To(BeZero(), "expected formation to be deleted")
 		})
 	})
+
+	Context("When an invalid formation is created", func() {
+		It("should refuse to store the formation", func() {
+			formation := domain.NewFormationEntity().WithDefaults().
+				WithRowsFromJson(duplicatePositionRowsJson).
+				Build()
+
+			err := formationRepo.Create(ctx, formation)
+			Expect(err).To(MatchError(domain.ErrDuplicatePosition), "expected duplicate position to be refused")
+
+			query, args := sql.NewQuery().Count().From(infra.TableName).Where(infra.FieldId, formation.GetId()).Build()
+			count, err := database.QueryOne(ctx, suite.Database, query, args, database.ScanInt)
+
+			Expect(err).ToNot(HaveOccurred(), "failed to count formations")
+			Expect(count).To(BeZero(), "expected formation not to be stored")
+		})
+	})
+
+	Context("When a formation of a character is updated", func() {
+		var ownedUnit, foreignUnit *unitDomain.UnitEntity
+		var formation *domain.FormationEntity
+
+		BeforeAll(func() {
+			character := characterDomain.NewCharacterEntity().WithDefaults().Build()
+			err := characterInfra.NewCharacterRepositoryImpl(suite.Database).Create(ctx, character)
+			Expect(err).ToNot(HaveOccurred(), "failed to create character")
+
+			ownedUnit = unitDomain.NewUnitEntity().WithDefaults().Build()
+			foreignUnit = unitDomain.NewUnitEntity().WithDefaults().Build()
+			err = unitInfra.NewUnitRepositoryImpl(suite.Database).Create(ctx, ownedUnit, foreignUnit)
+			Expect(err).ToNot(HaveOccurred(), "failed to create units")
+
+			characterUnit := characterUnitDomain.NewCharacterUnitEntity().
+				WithCharacterId(character.GetId()).
+				WithUnitId(ownedUnit.GetId()).
+				Build()
+			err = characterUnitInfra.NewCharacterUnitRepositoryImpl(suite.Database).Create(ctx, characterUnit)
+			Expect(err).ToNot(HaveOccurred(), "failed to create character unit")
+
+			formation = domain.NewFormationEntity().WithDefaults().Build()
+			err = formationRepo.Create(ctx, formation)
+			Expect(err).ToNot(HaveOccurred(), "failed to create formation")
+
+			characterFormation := characterFormationDomain.NewCharacterFormationEntity().
+				WithCharacterId(character.GetId()).
+				WithId(formation.GetId()).
+				Build()
+			err = characterFormationInfra.NewCharacterFormationRepositoryImpl(suite.Database).Create(ctx, characterFormation)
+			Expect(err).ToNot(HaveOccurred(), "failed to create character formation")
+		})
+
+		It("should accept units that belong to the character", func() {
+			formation.Rows = []*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+				{PositionX: 0, PositionY: 0, UnitId: ownedUnit.GetId()},
+			}}}
+
+			err := formationRepo.Update(ctx, formation)
+			Expect(err).ToNot(HaveOccurred(), "failed to update formation")
+		})
+
+		It("should refuse units that belong to another character", func() {
+			formation.Rows = []*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+				{PositionX: 0, PositionY: 0, UnitId: foreignUnit.GetId()},
+			}}}
+
+			err := formationRepo.Update(ctx, formation)
+			Expect(err).To(MatchError(domain.ErrUnitNotOwned), "expected foreign unit to be refused")
+		})
+
+		It("should refuse upserting units that belong to another character", func() {
+			formation.Rows = []*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+				{PositionX: 0, PositionY: 0, UnitId: foreignUnit.GetId()},
+			}}}
+
+			err := formationRepo.Upsert(ctx, formation)
+			Expect(err).To(MatchError(domain.ErrUnitNotOwned), "expected foreign unit to be refused")
+		})
+	})
+
+	Context("When a formation is created for a character", func() {
+		var character *characterDomain.CharacterEntity
+		var ownedUnit, foreignUnit *unitDomain.UnitEntity
+
+		BeforeAll(func() {
+			character = characterDomain.NewCharacterEntity().WithDefaults().Build()
+			err := characterInfra.NewCharacterRepositoryImpl(suite.Database).Create(ctx, character)
+			Expect(err).ToNot(HaveOccurred(), "failed to create character")
+
+			ownedUnit = unitDomain.NewUnitEntity().WithDefaults().Build()
+			foreignUnit = unitDomain.NewUnitEntity().WithDefaults().Build()
+			err = unitInfra.NewUnitRepositoryImpl(suite.Database).Create(ctx, ownedUnit, foreignUnit)
+			Expect(err).ToNot(HaveOccurred(), "failed to create units")
+
+			characterUnit := characterUnitDomain.NewCharacterUnitEntity().
+				WithCharacterId(character.GetId()).
+				WithUnitId(ownedUnit.GetId()).
+				Build()
+			err = characterUnitInfra.NewCharacterUnitRepositoryImpl(suite.Database).Create(ctx, characterUnit)
+			Expect(err).ToNot(HaveOccurred(), "failed to create character unit")
+		})
+
+		placing := func(unitId string) *domain.FormationEntity {
+			return domain.NewFormationEntity().WithDefaults().
+				WithRows([]*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+					{PositionX: 0, PositionY: 0, UnitId: unitId},
+				}}}).
+				Build()
+		}
+
+		countFormations := func(formationId string) int {
+			query, args := sql.NewQuery().Count().From(infra.TableName).Where(infra.FieldId, formationId).Build()
+			count, err := database.QueryOne(ctx, suite.Database, query, args, database.ScanInt)
+			Expect(err).ToNot(HaveOccurred(), "failed to count formations")
+			return count
+		}
+
+		It("should store the formation and assign it to the character", func() {
+			formation := placing(ownedUnit.GetId())
+			err := formationRepo.CreateForCharacter(ctx, character.GetId(), formation)
+			Expect(err).ToNot(HaveOccurred(), "failed to create formation")
+
+			links, err := characterFormationInfra.NewCharacterFormationRepositoryImpl(suite.Database).GetByFormationId(ctx, formation.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get character formations")
+			Expect(links).To(HaveLen(1))
+			Expect(links[0].GetCharacterId()).To(Equal(character.GetId()))
+		})
+
+		It("should refuse to create a formation with units of another character", func() {
+			formation := placing(foreignUnit.GetId())
+			err := formationRepo.CreateForCharacter(ctx, character.GetId(), formation)
+			Expect(err).To(MatchError(domain.ErrUnitNotOwned), "expected foreign unit to be refused")
+			Expect(countFormations(formation.GetId())).To(BeZero(), "expected formation not to be stored")
+		})
+
+		It("should refuse to upsert a formation with units of another character", func() {
+			formation := placing(foreignUnit.GetId())
+			err := formationRepo.UpsertForCharacter(ctx, character.GetId(), formation)
+			Expect(err).To(MatchError(domain.ErrUnitNotOwned), "expected foreign unit to be refused")
+			Expect(countFormations(formation.GetId())).To(BeZero(), "expected formation not to be stored")
+		})
+
+		It("should upsert a formation that was already assigned to the character", func() {
+			formation := placing(ownedUnit.GetId())
+			Expect(formationRepo.UpsertForCharacter(ctx, character.GetId(), formation)).To(Succeed())
+			Expect(formationRepo.UpsertForCharacter(ctx, character.GetId(), formation)).To(Succeed())
+		})
+
+		It("should refuse a formation without a character", func() {
+			err := formationRepo.CreateForCharacter(ctx, "", placing(ownedUnit.GetId()))
+			Expect(err).To(MatchError(domain.ErrMissingCharacter))
+		})
+	})
 })
@@ -0,0 +1,140 @@
+package integration
+
+import (
+	"context"
+	characterFormationInfra "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
+	"shvdg/crazed-conquerer/internal/domains/formation/application"
+	"shvdg/crazed-conquerer/internal/domains/formation/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
+	userCharacterApplication "shvdg/crazed-conquerer/internal/domains/user-character/application"
+	userCharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+	userCharacterInfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/fixtures"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Formation Service", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var graph, other *fixtures.Graph
+	var formationService *application.FormationService
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+
+		graph, err = fixtures.NewGraph().WithUnitsPerCharacter(2).Create(ctx, suite.Database)
+		Expect(err).ToNot(HaveOccurred(), "failed to create graph")
+		other, err = fixtures.NewGraph().WithUnitsPerCharacter(1).Create(ctx, suite.Database)
+		Expect(err).ToNot(HaveOccurred(), "failed to create other graph")
+
+		owners := userCharacterApplication.NewUserCharacterService(userCharacterInfra.NewUserCharacterRepositoryImpl(suite.Database))
+		formationService = application.NewFormationService(infra.NewFormationRepositoryImpl(suite.Database),
+			characterFormationInfra.NewCharacterFormationRepositoryImpl(suite.Database), owners)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When the formation of a character is retrieved", func() {
+		It("should return the formation of the character", func() {
+			formation, err := formationService.GetCharacterFormation(ctx, graph.User.GetId(), graph.Characters[0].Character.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get formation")
+			Expect(formation.GetId()).To(Equal(graph.Characters[0].Formation.GetId()))
+		})
+
+		It("should refuse a character of another user", func() {
+			_, err := formationService.GetCharacterFormation(ctx, graph.User.GetId(), other.Characters[0].Character.GetId())
+			Expect(err).To(MatchError(userCharacterDomain.ErrCharacterNotOwned))
+		})
+	})
+
+	Context("When units are placed in a formation", func() {
+		It("should store the new placement", func() {
+			unitIds := graph.Characters[0].UnitIds()
+			rows := []*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+				{PositionX: 4, PositionY: 4, UnitId: unitIds[0]},
+				{PositionX: 3, PositionY: 4, UnitId: unitIds[1]},
+			}}}
+
+			_, err := formationService.PlaceUnits(ctx, graph.User.GetId(), graph.Characters[0].Formation.GetId(), rows)
+			Expect(err).ToNot(HaveOccurred(), "failed to place units")
+
+			formation, err := formationService.GetCharacterFormation(ctx, graph.User.GetId(), graph.Characters[0].Character.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get formation")
+			Expect(formation.GetRows()[0].GetColumns()[0].GetPositionX()).To(Equal(int32(4)))
+		})
+
+		It("should refuse units of another character", func() {
+			rows := []*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+				{PositionX: 0, PositionY: 0, UnitId: other.Characters[0].UnitIds()[0]},
+			}}}
+
+			_, err := formationService.PlaceUnits(ctx, graph.User.GetId(), graph.Characters[0].Formation.GetId(), rows)
+			Expect(err).To(MatchError(domain.ErrUnitNotOwned))
+		})
+
+		It("should refuse a formation of another user", func() {
+			_, err := formationService.PlaceUnits(ctx, graph.User.GetId(), other.Characters[0].Formation.GetId(), nil)
+			Expect(err).To(MatchError(domain.ErrFormationNotOwned))
+		})
+	})
+
+	Context("When the formation of a character is created", func() {
+		var fresh *fixtures.Graph
+		var characterId string
+
+		BeforeAll(func() {
+			fresh, err = fixtures.NewGraph().WithUnitsPerCharacter(2).Build()
+			Expect(err).ToNot(HaveOccurred(), "failed to build graph")
+			fresh.Characters[0].Formation = nil
+			Expect(fixtures.Insert(ctx, suite.Database, fresh)).To(Succeed(), "failed to insert graph without formation")
+			characterId = fresh.Characters[0].Character.GetId()
+		})
+
+		It("should refuse units of another character", func() {
+			rows := []*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+				{PositionX: 0, PositionY: 0, UnitId: other.Characters[0].UnitIds()[0]},
+			}}}
+
+			_, err := formationService.CreateFormation(ctx, fresh.User.GetId(), characterId, rows)
+			Expect(err).To(MatchError(domain.ErrUnitNotOwned))
+		})
+
+		It("should refuse a character of another user", func() {
+			_, err := formationService.CreateFormation(ctx, graph.User.GetId(), characterId, nil)
+			Expect(err).To(MatchError(userCharacterDomain.ErrCharacterNotOwned))
+		})
+
+		It("should create the formation and assign it to the character", func() {
+			rows := []*domain.FormationRowEntity{{Columns: []*domain.FormationColumnEntity{
+				{PositionX: 0, PositionY: 0, UnitId: fresh.Characters[0].UnitIds()[0]},
+			}}}
+
+			created, err := formationService.CreateFormation(ctx, fresh.User.GetId(), characterId, rows)
+			Expect(err).ToNot(HaveOccurred(), "failed to create formation")
+
+			formation, err := formationService.GetCharacterFormation(ctx, fresh.User.GetId(), characterId)
+			Expect(err).ToNot(HaveOccurred(), "failed to get formation")
+			Expect(formation.GetId()).To(Equal(created.GetId()))
+		})
+
+		It("should refuse a second formation for the character", func() {
+			_, err := formationService.CreateFormation(ctx, fresh.User.GetId(), characterId, nil)
+			Expect(err).To(MatchError(domain.ErrFormationExists))
+		})
+	})
+})
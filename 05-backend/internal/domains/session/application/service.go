@@ -0,0 +1,192 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/session/domain"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/tokens"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Authenticator verifies the credentials of a user when they log in
+type Authenticator interface {
+	Login(ctx context.Context, email, password string) (*userDomain.UserEntity, error)
+}
+
+// Tokens are what a user receives once they have logged in or refreshed their session
+type Tokens struct {
+	UserId                string
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// SessionService issues access tokens together with refresh tokens that are rotated on every use.
+// A refresh token that is used twice revokes every token rotated from the same login.
+type SessionService struct {
+	connection    database.Connection
+	users         Authenticator
+	refreshTokens domain.RefreshTokenRepository
+	signer        *tokens.Signer
+	timeToLive    time.Duration
+}
+
+// SessionServiceOpt configures the SessionService during initialization
+type SessionServiceOpt func(*SessionService)
+
+// WithRefreshTokenTimeToLive sets how long refresh tokens remain valid
+func WithRefreshTokenTimeToLive(timeToLive time.Duration) SessionServiceOpt {
+	return func(s *SessionService) {
+		s.timeToLive = timeToLive
+	}
+}
+
+// NewSessionService instantiates a new SessionService instance, refresh tokens are rotated in transactions on the connection
+func NewSessionService(connection database.Connection, users Authenticator, refreshTokens domain.RefreshTokenRepository, signer *tokens.Signer, options ...SessionServiceOpt) *SessionService {
+	service := &SessionService{
+		connection:    connection,
+		users:         users,
+		refreshTokens: refreshTokens,
+		signer:        signer,
+		timeToLive:    domain.DefaultRefreshTokenTimeToLive,
+	}
+
+	for _, option := range options {
+		option(service)
+	}
+
+	return service
+}
+
+// Login verifies the credentials of the user and issues the tokens of a new session
+func (s *SessionService) Login(ctx context.Context, email, password string) (*Tokens, error) {
+	user, err := s.users.Login(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issue(ctx, user.GetId(), uuid.NewString())
+}
+
+// Refresh exchanges the refresh token for new tokens of the same session, the refresh token can not be used again.
+// The token is revoked and its successor issued in one transaction, so a concurrent use of the same token waits for it
+// and then revokes the whole session, successor included.
+// ErrInvalidRefreshToken, ErrExpiredRefreshToken and ErrReusedRefreshToken are returned when the token is refused.
+func (s *SessionService) Refresh(ctx context.Context, refreshToken string) (*Tokens, error) {
+	entity, err := s.find(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if entity.IsRevoked() {
+		return nil, s.revokeReused(ctx, entity)
+	}
+	if entity.IsExpired(time.Now()) {
+		return nil, domain.ErrExpiredRefreshToken
+	}
+
+	var issued *Tokens
+	err = database.InTransaction(ctx, s.connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		revoked, err := s.refreshTokens.Revoke(ctx, entity.GetId())
+		if err != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", err)
+		}
+		if !revoked {
+			return domain.ErrReusedRefreshToken
+		}
+
+		issued, err = s.issue(ctx, entity.GetUserId(), entity.GetFamilyId())
+		return err
+	})
+	if errors.Is(err, domain.ErrReusedRefreshToken) {
+		return nil, s.revokeReused(ctx, entity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+// Logout revokes the refresh token and every other token of its session
+func (s *SessionService) Logout(ctx context.Context, refreshToken string) error {
+	entity, err := s.find(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if err := s.refreshTokens.RevokeFamily(ctx, entity.GetFamilyId()); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+// RevokeUser revokes every refresh token of the user, ending all of their sessions
+func (s *SessionService) RevokeUser(ctx context.Context, userId string) error {
+	if err := s.refreshTokens.RevokeUser(ctx, userId); err != nil {
+		return fmt.Errorf("failed to revoke sessions of user '%s': %w", userId, err)
+	}
+	return nil
+}
+
+// find retrieves the stored refresh token by the hash of the token
+func (s *SessionService) find(ctx context.Context, refreshToken string) (*domain.RefreshTokenEntity, error) {
+	entity, err := s.refreshTokens.GetByTokenHash(ctx, tokens.HashOpaque(refreshToken))
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, domain.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+	return entity, nil
+}
+
+// revokeReused revokes the session of a refresh token that was used before and returns ErrReusedRefreshToken
+func (s *SessionService) revokeReused(ctx context.Context, entity *domain.RefreshTokenEntity) error {
+	if err := s.refreshTokens.RevokeFamily(ctx, entity.GetFamilyId()); err != nil {
+		return errors.Join(domain.ErrReusedRefreshToken, fmt.Errorf("failed to revoke session: %w", err))
+	}
+	return domain.ErrReusedRefreshToken
+}
+
+// issue signs an access token for the user and stores a new refresh token within the family
+func (s *SessionService) issue(ctx context.Context, userId, familyId string) (*Tokens, error) {
+	accessToken, claims, err := s.signer.Sign(userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	refreshToken, err := tokens.NewOpaque()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entity := &domain.RefreshTokenEntity{
+		Id:        uuid.NewString(),
+		UserId:    userId,
+		FamilyId:  familyId,
+		TokenHash: tokens.HashOpaque(refreshToken),
+		ExpiresAt: converters.TimeToTimestamp(now.Add(s.timeToLive)),
+		CreatedAt: converters.TimeToTimestamp(now),
+	}
+
+	if err := s.refreshTokens.Create(ctx, entity); err != nil {
+		return nil, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return &Tokens{
+		UserId:                userId,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  time.Unix(claims.ExpiresAt, 0),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: now.Add(s.timeToLive),
+	}, nil
+}
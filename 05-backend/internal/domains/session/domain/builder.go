@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+	"time"
+)
+
+// DefaultRefreshTokenTimeToLive is how long a refresh token remains valid
+const DefaultRefreshTokenTimeToLive = 30 * 24 * time.Hour
+
+// RefreshTokenEntityBuilder helps build and configure a RefreshTokenEntity object.
+type RefreshTokenEntityBuilder struct {
+	refreshTokenEntity *RefreshTokenEntity
+	faker              *faker.Faker
+}
+
+// NewRefreshTokenEntity initializes a new RefreshTokenEntityBuilder with empty values.
+func NewRefreshTokenEntity() *RefreshTokenEntityBuilder {
+	return &RefreshTokenEntityBuilder{refreshTokenEntity: &RefreshTokenEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *RefreshTokenEntityBuilder) WithFaker(faker *faker.Faker) *RefreshTokenEntityBuilder {
+	b.faker = faker
+	return b
+}
+
+// WithId sets the id of the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithId(id string) *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.Id = id
+	return b
+}
+
+// WithRandomId sets a random id for the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithRandomId() *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.Id = b.faker.UUID()
+	return b
+}
+
+// WithUserId sets the id of the user the refresh token entity belongs to.
+func (b *RefreshTokenEntityBuilder) WithUserId(userId string) *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.UserId = userId
+	return b
+}
+
+// WithRandomUserId sets a random user id for the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithRandomUserId() *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.UserId = b.faker.UUID()
+	return b
+}
+
+// WithFamilyId sets the family the refresh token entity was rotated within.
+func (b *RefreshTokenEntityBuilder) WithFamilyId(familyId string) *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.FamilyId = familyId
+	return b
+}
+
+// WithRandomFamilyId sets a random family id for the refresh token entity, starting a new family.
+func (b *RefreshTokenEntityBuilder) WithRandomFamilyId() *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.FamilyId = b.faker.UUID()
+	return b
+}
+
+// WithTokenHash sets the hash of the token of the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithTokenHash(tokenHash string) *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.TokenHash = tokenHash
+	return b
+}
+
+// WithRandomTokenHash sets a random token hash for the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithRandomTokenHash() *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.TokenHash = b.faker.DigitN(64)
+	return b
+}
+
+// WithExpiresAt sets the expiry time of the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithExpiresAt(t time.Time) *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.ExpiresAt = converters.TimeToTimestamp(t)
+	return b
+}
+
+// WithRevokedAt sets the revocation time of the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithRevokedAt(t time.Time) *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.RevokedAt = converters.TimeToTimestamp(t)
+	return b
+}
+
+// WithCreatedAt sets the creation time of the refresh token entity.
+func (b *RefreshTokenEntityBuilder) WithCreatedAt(t time.Time) *RefreshTokenEntityBuilder {
+	b.refreshTokenEntity.CreatedAt = converters.TimeToTimestamp(t)
+	return b
+}
+
+// WithDefaults populates all fields with random default values.
+func (b *RefreshTokenEntityBuilder) WithDefaults() *RefreshTokenEntityBuilder {
+	now := time.Now()
+	return b.WithRandomId().
+		WithRandomUserId().
+		WithRandomFamilyId().
+		WithRandomTokenHash().
+		WithExpiresAt(now.Add(DefaultRefreshTokenTimeToLive)).
+		WithCreatedAt(now)
+}
+
+// Build returns the configured RefreshTokenEntity object.
+func (b *RefreshTokenEntityBuilder) Build() *RefreshTokenEntity {
+	return b.refreshTokenEntity
+}
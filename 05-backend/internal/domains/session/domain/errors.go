@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+// Errors returned when a refresh token is refused
+var (
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid")
+	ErrExpiredRefreshToken = errors.New("refresh token has expired")
+	ErrReusedRefreshToken  = errors.New("refresh token has been used before, its session is revoked")
+)
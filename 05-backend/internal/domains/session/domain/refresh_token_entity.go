@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"time"
+)
+
+// IsRevoked returns whether the refresh token has been rotated or revoked
+func (x *RefreshTokenEntity) IsRevoked() bool {
+	return x.GetRevokedAt() != nil
+}
+
+// IsExpired returns whether the refresh token has expired at the given time
+func (x *RefreshTokenEntity) IsExpired(now time.Time) bool {
+	return !now.Before(converters.TimestampToTime(x.GetExpiresAt()))
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "context"
+
+// RefreshTokenRepository representation of a refresh token repository
+type RefreshTokenRepository interface {
+	GetByTokenHash(ctx context.Context, tokenHash string) (*RefreshTokenEntity, error)
+	Create(ctx context.Context, entities ...*RefreshTokenEntity) error
+	Revoke(ctx context.Context, id string) (bool, error)
+	RevokeFamily(ctx context.Context, familyId string) error
+	RevokeUser(ctx context.Context, userId string) error
+}
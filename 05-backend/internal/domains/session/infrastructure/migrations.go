@@ -0,0 +1,12 @@
+package infrastructure
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+)
+
+// Migrations returns the versioned changes to the refresh_tokens table
+func Migrations() migrations.Domain {
+	return migrations.NewDomain(TableName, []string{"users"},
+		migrations.NewMigration(1, "create refresh_tokens table", CreateTableQuery, DropTableQuery),
+	)
+}
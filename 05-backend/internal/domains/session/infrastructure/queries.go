@@ -0,0 +1,42 @@
+package infrastructure
+
+// Names
+const (
+	TableName = "refresh_tokens"
+
+	FieldId        = "id"
+	FieldUserId    = "user_id"
+	FieldFamilyId  = "family_id"
+	FieldTokenHash = "token_hash"
+	FieldExpiresAt = "expires_at"
+	FieldRevokedAt = "revoked_at"
+	FieldCreatedAt = "created_at"
+)
+
+// SQL query constants
+const (
+	CreateTableQuery = `
+		CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+			` + FieldId + ` VARCHAR(255) PRIMARY KEY,
+			` + FieldUserId + ` VARCHAR(255) NOT NULL,
+			` + FieldFamilyId + ` VARCHAR(255) NOT NULL,
+			` + FieldTokenHash + ` CHAR(64) UNIQUE NOT NULL,
+			` + FieldExpiresAt + ` TIMESTAMPTZ NOT NULL,
+			` + FieldRevokedAt + ` TIMESTAMPTZ,
+			` + FieldCreatedAt + ` TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			CONSTRAINT fk_user FOREIGN KEY (` + FieldUserId + `) REFERENCES users(id) ON DELETE CASCADE
+		);
+		CREATE INDEX IF NOT EXISTS idx_` + TableName + `_` + FieldFamilyId + ` ON ` + TableName + ` (` + FieldFamilyId + `);
+	`
+
+	DropTableQuery = `DROP TABLE IF EXISTS ` + TableName + ` CASCADE;`
+
+	// RevokeQuery revokes a token unless it was revoked already, returning its id when it was revoked by this query
+	RevokeQuery = `UPDATE ` + TableName + ` SET ` + FieldRevokedAt + ` = NOW() WHERE ` + FieldId + ` = $1 AND ` + FieldRevokedAt + ` IS NULL RETURNING ` + FieldId
+
+	// RevokeFamilyQuery revokes every token of a family that was not revoked yet
+	RevokeFamilyQuery = `UPDATE ` + TableName + ` SET ` + FieldRevokedAt + ` = NOW() WHERE ` + FieldFamilyId + ` = $1 AND ` + FieldRevokedAt + ` IS NULL`
+
+	// RevokeUserQuery revokes every token of a user that was not revoked yet
+	RevokeUserQuery = `UPDATE ` + TableName + ` SET ` + FieldRevokedAt + ` = NOW() WHERE ` + FieldUserId + ` = $1 AND ` + FieldRevokedAt + ` IS NULL`
+)
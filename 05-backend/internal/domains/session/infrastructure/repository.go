@@ -0,0 +1,80 @@
+package infrastructure
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/session/domain"
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/sql"
+)
+
+// RefreshTokenRepositoryImpl provides the concrete implementation of the RefreshTokenRepository interface
+type RefreshTokenRepositoryImpl struct {
+	database.Connection
+}
+
+// NewRefreshTokenRepositoryImpl creates a new instance of RefreshTokenRepositoryImpl
+func NewRefreshTokenRepositoryImpl(connection database.Connection) *RefreshTokenRepositoryImpl {
+	return &RefreshTokenRepositoryImpl{connection}
+}
+
+// GetByTokenHash retrieves a refresh token by the hash of its token
+func (s *RefreshTokenRepositoryImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshTokenEntity, error) {
+	query, args := sql.NewQuery().
+		Select(FieldId, FieldUserId, FieldFamilyId, FieldTokenHash, FieldExpiresAt, FieldRevokedAt, FieldCreatedAt).
+		From(TableName).
+		Where(FieldTokenHash, tokenHash).
+		Build()
+
+	return s.ReadOne(ctx, query, args, ScanRefreshTokenEntity)
+}
+
+// Create inserts one or more refresh token entities into the database
+func (s *RefreshTokenRepositoryImpl) Create(ctx context.Context, entities ...*domain.RefreshTokenEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		argSets[i] = []any{entity.GetId(), entity.GetUserId(), entity.GetFamilyId(), entity.GetTokenHash(),
+			converters.TimestampToTime(entity.GetExpiresAt())}
+	}
+
+	query, batchArgs := sql.NewQuery().
+		InsertInto(TableName).
+		InsertFields(FieldId, FieldUserId, FieldFamilyId, FieldTokenHash, FieldExpiresAt).
+		BatchValues(argSets).
+		BuildBatch()
+
+	return database.Batch(ctx, s.Connection, query, batchArgs)
+}
+
+// Revoke revokes the refresh token and returns whether it was revoked by this call rather than before
+func (s *RefreshTokenRepositoryImpl) Revoke(ctx context.Context, id string) (bool, error) {
+	revoked, err := database.QueryMany(ctx, s.Connection, RevokeQuery, []any{id}, database.ScanString)
+	if err != nil {
+		return false, err
+	}
+	return len(revoked) > 0, nil
+}
+
+// RevokeFamily revokes every refresh token rotated from the same login
+func (s *RefreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyId string) error {
+	return database.Execute(ctx, s.Connection, RevokeFamilyQuery, familyId)
+}
+
+// RevokeUser revokes every refresh token of the user
+func (s *RefreshTokenRepositoryImpl) RevokeUser(ctx context.Context, userId string) error {
+	return database.Execute(ctx, s.Connection, RevokeUserQuery, userId)
+}
+
+// ReadOne executes a query and returns a single refresh token entity
+func (s *RefreshTokenRepositoryImpl) ReadOne(ctx context.Context, query string, values []any, scan database.ScannerFunc[*domain.RefreshTokenEntity]) (*domain.RefreshTokenEntity, error) {
+	return database.QueryOne(ctx, s.Connection, query, values, scan)
+}
+
+// ReadMany executes a query and returns multiple refresh token entities
+func (s *RefreshTokenRepositoryImpl) ReadMany(ctx context.Context, query string, values []any, scan database.ScannerFunc[*domain.RefreshTokenEntity]) ([]*domain.RefreshTokenEntity, error) {
+	return database.QueryMany(ctx, s.Connection, query, values, scan)
+}
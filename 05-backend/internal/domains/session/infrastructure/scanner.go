@@ -0,0 +1,37 @@
+package infrastructure
+
+import (
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/session/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ScanRefreshTokenEntity scans database row data into a RefreshTokenEntity
+func ScanRefreshTokenEntity(scanner database.RowScanner) (*domain.RefreshTokenEntity, error) {
+	var id, userId, familyId, tokenHash string
+	var expiresAt, revokedAt, createdAt pgtype.Timestamp
+
+	if err := scanner.Scan(&id, &userId, &familyId, &tokenHash, &expiresAt, &revokedAt, &createdAt); err != nil {
+		return nil, fmt.Errorf("failed to scan refresh token entity: %w", err)
+	}
+
+	builder := domain.NewRefreshTokenEntity().
+		WithId(id).
+		WithUserId(userId).
+		WithFamilyId(familyId).
+		WithTokenHash(tokenHash)
+
+	if expiresAt.Valid {
+		builder = builder.WithExpiresAt(expiresAt.Time)
+	}
+	if revokedAt.Valid {
+		builder = builder.WithRevokedAt(revokedAt.Time)
+	}
+	if createdAt.Valid {
+		builder = builder.WithCreatedAt(createdAt.Time)
+	}
+
+	return builder.Build(), nil
+}
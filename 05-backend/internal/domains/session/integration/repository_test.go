@@ -0,0 +1,123 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/session/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/session/infrastructure"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	userInfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Refresh Token Repository", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var user *userDomain.UserEntity
+	var refreshTokenRepo *infra.RefreshTokenRepositoryImpl
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+		refreshTokenRepo = infra.NewRefreshTokenRepositoryImpl(suite.Database)
+
+		user = userDomain.NewUserEntity().WithDefaults().Build()
+		err = userInfra.NewUserRepositoryImpl(suite.Database).Create(ctx, user)
+		Expect(err).ToNot(HaveOccurred(), "failed to create user")
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When a refresh token is created", func() {
+		It("should be retrievable by its token hash", func() {
+			token := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(user.GetId()).Build()
+			Expect(refreshTokenRepo.Create(ctx, token)).To(Succeed())
+
+			retrieved, err := refreshTokenRepo.GetByTokenHash(ctx, token.GetTokenHash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retrieved.GetId()).To(Equal(token.GetId()))
+			Expect(retrieved.GetFamilyId()).To(Equal(token.GetFamilyId()))
+			Expect(retrieved.IsRevoked()).To(BeFalse())
+		})
+
+		It("should return not found for an unknown token hash", func() {
+			_, err := refreshTokenRepo.GetByTokenHash(ctx, domain.NewRefreshTokenEntity().WithRandomTokenHash().Build().GetTokenHash())
+			Expect(err).To(MatchError(database.ErrNotFound))
+		})
+	})
+
+	Context("When a refresh token is revoked", func() {
+		It("should only be revoked once", func() {
+			token := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(user.GetId()).Build()
+			Expect(refreshTokenRepo.Create(ctx, token)).To(Succeed())
+
+			revoked, err := refreshTokenRepo.Revoke(ctx, token.GetId())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			revoked, err = refreshTokenRepo.Revoke(ctx, token.GetId())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+
+			retrieved, err := refreshTokenRepo.GetByTokenHash(ctx, token.GetTokenHash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retrieved.IsRevoked()).To(BeTrue())
+		})
+
+		It("should revoke every token of the family", func() {
+			first := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(user.GetId()).Build()
+			second := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(user.GetId()).WithFamilyId(first.GetFamilyId()).Build()
+			other := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(user.GetId()).Build()
+			Expect(refreshTokenRepo.Create(ctx, first, second, other)).To(Succeed())
+
+			Expect(refreshTokenRepo.RevokeFamily(ctx, first.GetFamilyId())).To(Succeed())
+
+			for _, token := range []*domain.RefreshTokenEntity{first, second} {
+				retrieved, err := refreshTokenRepo.GetByTokenHash(ctx, token.GetTokenHash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retrieved.IsRevoked()).To(BeTrue())
+			}
+
+			retrieved, err := refreshTokenRepo.GetByTokenHash(ctx, other.GetTokenHash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retrieved.IsRevoked()).To(BeFalse())
+		})
+
+		It("should revoke every token of the user", func() {
+			otherUser := userDomain.NewUserEntity().WithDefaults().Build()
+			Expect(userInfra.NewUserRepositoryImpl(suite.Database).Create(ctx, otherUser)).To(Succeed())
+
+			first := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(user.GetId()).Build()
+			second := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(user.GetId()).Build()
+			other := domain.NewRefreshTokenEntity().WithDefaults().WithUserId(otherUser.GetId()).Build()
+			Expect(refreshTokenRepo.Create(ctx, first, second, other)).To(Succeed())
+
+			Expect(refreshTokenRepo.RevokeUser(ctx, user.GetId())).To(Succeed())
+
+			for _, token := range []*domain.RefreshTokenEntity{first, second} {
+				retrieved, err := refreshTokenRepo.GetByTokenHash(ctx, token.GetTokenHash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retrieved.IsRevoked()).To(BeTrue())
+			}
+
+			retrieved, err := refreshTokenRepo.GetByTokenHash(ctx, other.GetTokenHash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retrieved.IsRevoked()).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/session/application"
+	"shvdg/crazed-conquerer/internal/domains/session/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/session/infrastructure"
+	userApplication "shvdg/crazed-conquerer/internal/domains/user/application"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	userInfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"shvdg/crazed-conquerer/internal/shared/tokens"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Session Service", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var signer *tokens.Signer
+	var user *userDomain.UserEntity
+	var password string
+	var sessionService *application.SessionService
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+
+		signer, err = tokens.NewSigner([]byte("a-secret-that-is-long-enough-to-sign"))
+		Expect(err).ToNot(HaveOccurred(), "failed to create signer")
+
+		userRepo := userInfra.NewUserRepositoryImpl(suite.Database)
+		user = userDomain.NewUserEntity().WithDefaults().Build()
+		password = user.GetPassword()
+		user.Password, err = passwords.Default().Hash(password)
+		Expect(err).ToNot(HaveOccurred(), "failed to hash password")
+		Expect(userRepo.Create(ctx, user)).To(Succeed(), "failed to create user")
+
+		users := userApplication.NewUserService(userRepo, passwords.Default())
+		sessionService = application.NewSessionService(suite.Database, users, infra.NewRefreshTokenRepositoryImpl(suite.Database), signer)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When a user logs in", func() {
+		It("should issue an access token for the user", func() {
+			issued, err := sessionService.Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(issued.UserId).To(Equal(user.GetId()))
+			Expect(issued.RefreshToken).ToNot(BeEmpty())
+			Expect(issued.RefreshTokenExpiresAt).To(BeTemporally("~", time.Now().Add(domain.DefaultRefreshTokenTimeToLive), time.Minute))
+
+			claims, err := signer.Verify(issued.AccessToken)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims.Subject).To(Equal(user.GetId()))
+		})
+
+		It("should refuse a wrong password", func() {
+			_, err := sessionService.Login(ctx, user.GetEmail(), "wrong-password-1")
+			Expect(err).To(MatchError(userDomain.ErrInvalidCredentials))
+		})
+	})
+
+	Context("When a session is refreshed", func() {
+		It("should rotate the refresh token", func() {
+			issued, err := sessionService.Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred())
+
+			refreshed, err := sessionService.Refresh(ctx, issued.RefreshToken)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(refreshed.UserId).To(Equal(user.GetId()))
+			Expect(refreshed.RefreshToken).ToNot(Equal(issued.RefreshToken))
+
+			_, err = sessionService.Refresh(ctx, refreshed.RefreshToken)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should revoke the session when a refresh token is reused", func() {
+			issued, err := sessionService.Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred())
+
+			refreshed, err := sessionService.Refresh(ctx, issued.RefreshToken)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = sessionService.Refresh(ctx, issued.RefreshToken)
+			Expect(err).To(MatchError(domain.ErrReusedRefreshToken))
+
+			_, err = sessionService.Refresh(ctx, refreshed.RefreshToken)
+			Expect(err).To(MatchError(domain.ErrReusedRefreshToken))
+		})
+
+		It("should refuse an unknown refresh token", func() {
+			_, err := sessionService.Refresh(ctx, "unknown")
+			Expect(err).To(MatchError(domain.ErrInvalidRefreshToken))
+		})
+
+		It("should refuse an expired refresh token", func() {
+			expiring := application.NewSessionService(suite.Database, userApplication.NewUserService(userInfra.NewUserRepositoryImpl(suite.Database), passwords.Default()),
+				infra.NewRefreshTokenRepositoryImpl(suite.Database), signer, application.WithRefreshTokenTimeToLive(-time.Minute))
+
+			issued, err := expiring.Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = expiring.Refresh(ctx, issued.RefreshToken)
+			Expect(err).To(MatchError(domain.ErrExpiredRefreshToken))
+		})
+	})
+
+	Context("When a user logs out", func() {
+		It("should revoke the session", func() {
+			issued, err := sessionService.Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred())
+
+			refreshed, err := sessionService.Refresh(ctx, issued.RefreshToken)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sessionService.Logout(ctx, refreshed.RefreshToken)).To(Succeed())
+
+			_, err = sessionService.Refresh(ctx, refreshed.RefreshToken)
+			Expect(err).To(MatchError(domain.ErrReusedRefreshToken))
+		})
+	})
+})
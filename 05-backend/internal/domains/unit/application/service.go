@@ -1 +1,116 @@
 package application
+
+import (
+	"context"
+	"fmt"
+	characterUnitDomain "shvdg/crazed-conquerer/internal/domains/character-unit/domain"
+	"shvdg/crazed-conquerer/internal/domains/unit/domain"
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/types"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CharacterOwners tells which characters belong to which users
+type CharacterOwners interface {
+	VerifyOwner(ctx context.Context, userId, characterId string) error
+	OwnsAny(ctx context.Context, userId string, characterIds ...string) (bool, error)
+}
+
+// UnitService handles unit-related operations on behalf of the user whose characters own the units
+type UnitService struct {
+	units          domain.UnitRepository
+	characterUnits characterUnitDomain.CharacterUnitRepository
+	owners         CharacterOwners
+}
+
+// NewUnitService instantiates a new UnitService instance
+func NewUnitService(units domain.UnitRepository, characterUnits characterUnitDomain.CharacterUnitRepository, owners CharacterOwners) *UnitService {
+	return &UnitService{units: units, characterUnits: characterUnits, owners: owners}
+}
+
+// GetCharacterUnits returns the units of the character once it is verified to belong to the user
+func (s *UnitService) GetCharacterUnits(ctx context.Context, userId, characterId string) ([]*domain.UnitEntity, error) {
+	if err := s.owners.VerifyOwner(ctx, userId, characterId); err != nil {
+		return nil, err
+	}
+
+	characterUnits, err := s.characterUnits.GetByCharacterId(ctx, characterId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get units of character '%s': %w", characterId, err)
+	}
+
+	unitIds := make([]string, len(characterUnits))
+	for i, characterUnit := range characterUnits {
+		unitIds[i] = characterUnit.GetUnitId()
+	}
+
+	units, err := s.units.GetByIds(ctx, unitIds...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get units of character '%s': %w", characterId, err)
+	}
+	return units, nil
+}
+
+// GetUnit returns the unit once it is verified to belong to one of the characters of the user, ErrUnitNotOwned otherwise
+func (s *UnitService) GetUnit(ctx context.Context, userId, unitId string) (*domain.UnitEntity, error) {
+	characterUnits, err := s.characterUnits.GetByUnitId(ctx, unitId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters of unit '%s': %w", unitId, err)
+	}
+
+	characterIds := make([]string, len(characterUnits))
+	for i, characterUnit := range characterUnits {
+		characterIds[i] = characterUnit.GetCharacterId()
+	}
+
+	owned, err := s.owners.OwnsAny(ctx, userId, characterIds...)
+	if err != nil {
+		return nil, err
+	}
+	if !owned {
+		return nil, domain.ErrUnitNotOwned
+	}
+
+	unit, err := s.units.GetById(ctx, unitId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unit '%s': %w", unitId, err)
+	}
+	return unit, nil
+}
+
+// RecruitUnit creates a unit at the recruit level for the character once it is verified to belong to the user.
+// ErrInvalidName, ErrInvalidVocation and ErrInvalidFaction are returned when the details break the rules for recruits.
+func (s *UnitService) RecruitUnit(ctx context.Context, userId, characterId, name string, vocation domain.Vocation, faction types.Faction) (*domain.UnitEntity, error) {
+	if err := s.owners.VerifyOwner(ctx, userId, characterId); err != nil {
+		return nil, err
+	}
+
+	name, err := domain.NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateVocation(vocation); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateFaction(faction); err != nil {
+		return nil, err
+	}
+
+	now := converters.TimeToTimestamp(time.Now())
+	unit := &domain.UnitEntity{
+		Id:        uuid.NewString(),
+		Name:      name,
+		Vocation:  vocation.String(),
+		Faction:   faction.String(),
+		Level:     domain.RecruitLevel,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.units.CreateForCharacter(ctx, characterId, unit); err != nil {
+		return nil, fmt.Errorf("failed to recruit unit for character '%s': %w", characterId, err)
+	}
+	return unit, nil
+}
@@ -3,16 +3,15 @@ package domain
 import (
 	"fmt"
 	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/faker"
 	"time"
-
-	fake "github.com/brianvoe/gofakeit/v6"
-	"github.com/google/uuid"
 )
 
 // UnitEntityBuilder helps build and configure a UnitEntity object.
 type UnitEntityBuilder struct {
 	unitEntity *UnitEntity
 	counter    uint64
+	faker      *faker.Faker
 }
 
 // GetNumber returns the current number of units.
@@ -22,7 +21,13 @@ func (b *UnitEntityBuilder) GetNumber() uint64 {
 
 // NewUnitEntity initializes a new UnitEntityBuilder with empty values.
 func NewUnitEntity() *UnitEntityBuilder {
-	return &UnitEntityBuilder{unitEntity: &UnitEntity{}}
+	return &UnitEntityBuilder{unitEntity: &UnitEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *UnitEntityBuilder) WithFaker(faker *faker.Faker) *UnitEntityBuilder {
+	b.faker = faker
+	return b
 }
 
 // WithId sets the ID of the unit entity.
@@ -33,7 +38,7 @@ func (b *UnitEntityBuilder) WithId(id string) *UnitEntityBuilder {
 
 // WithRandomId sets a random ID (UUID) for the unit entity.
 func (b *UnitEntityBuilder) WithRandomId() *UnitEntityBuilder {
-	b.unitEntity.Id = uuid.New().String()
+	b.unitEntity.Id = b.faker.UUID()
 	return b
 }
 
@@ -45,8 +50,14 @@ func (b *UnitEntityBuilder) WithVocation(vocation string) *UnitEntityBuilder {
 
 // WithRandomVocation sets a random vocation for the unit entity.
 func (b *UnitEntityBuilder) WithRandomVocation() *UnitEntityBuilder {
-	vocations := []string{"Warrior", "Mage", "Archer", "Rogue", "Paladin", "Priest", "Necromancer", "Berserker"}
-	b.unitEntity.Vocation = fake.RandomString(vocations)
+	vocations := []string{
+		Vocation_VOCATION_SWORDSMAN.String(),
+		Vocation_VOCATION_AXEMAN.String(),
+		Vocation_VOCATION_LUMBERJACK.String(),
+		Vocation_VOCATION_MINER.String(),
+		Vocation_VOCATION_SUMMONER.String(),
+	}
+	b.unitEntity.Vocation = b.faker.RandomString(vocations)
 	return b
 }
 
@@ -59,7 +70,7 @@ func (b *UnitEntityBuilder) WithFaction(faction string) *UnitEntityBuilder {
 // WithRandomFaction sets a random faction for the unit entity.
 func (b *UnitEntityBuilder) WithRandomFaction() *UnitEntityBuilder {
 	factions := []string{"Alliance", "Horde", "Neutral", "Empire", "Republic", "Rebels"}
-	b.unitEntity.Faction = fake.RandomString(factions)
+	b.unitEntity.Faction = b.faker.RandomString(factions)
 	return b
 }
 
@@ -71,7 +82,7 @@ func (b *UnitEntityBuilder) WithName(name string) *UnitEntityBuilder {
 
 // WithRandomName sets a random name for the unit entity.
 func (b *UnitEntityBuilder) WithRandomName() *UnitEntityBuilder {
-	b.unitEntity.Name = fmt.Sprintf("%s_%d", fake.Name(), b.counter)
+	b.unitEntity.Name = fmt.Sprintf("%s_%d", b.faker.Name(), b.counter)
 	return b
 }
 
@@ -83,7 +94,7 @@ func (b *UnitEntityBuilder) WithLevel(level string) *UnitEntityBuilder {
 
 // WithRandomLevel sets a random level for the unit entity.
 func (b *UnitEntityBuilder) WithRandomLevel() *UnitEntityBuilder {
-	b.unitEntity.Level = fmt.Sprintf("%d", fake.Number(1, 100))
+	b.unitEntity.Level = fmt.Sprintf("%d", b.faker.Number(1, 100))
 	return b
 }
 
@@ -101,7 +112,7 @@ func (b *UnitEntityBuilder) WithUpdatedAt(t time.Time) *UnitEntityBuilder {
 
 // WithDefaults populates all fields with random default values.
 func (b *UnitEntityBuilder) WithDefaults() *UnitEntityBuilder {
-	b.counter = NextUnitNumber()
+	b.counter = b.faker.Next("unit")
 
 	now := time.Now()
 	return b.WithRandomId().
@@ -0,0 +1,13 @@
+package domain
+
+import "errors"
+
+// ErrUnitNotOwned is returned when a unit does not belong to any character of the user
+var ErrUnitNotOwned = errors.New("unit does not belong to the user")
+
+// Errors returned when the details of a unit break one of the rules for recruits
+var (
+	ErrInvalidName     = errors.New("unit name is invalid")
+	ErrInvalidVocation = errors.New("vocation is not one a unit can be recruited with")
+	ErrInvalidFaction  = errors.New("faction is not one a unit can be recruited into")
+)
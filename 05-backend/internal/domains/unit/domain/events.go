@@ -1 +1,62 @@
 package domain
+
+import (
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/events"
+)
+
+// The types of the events raised by the unit domain
+const (
+	UnitRecruitedEvent           = "unit.recruited"
+	UnitAssignedToCharacterEvent = "unit.assigned_to_character"
+)
+
+// UnitRecruited is raised when a new unit has been stored
+type UnitRecruited struct {
+	events.Base
+	UnitId   string
+	Vocation string
+	Faction  string
+	Level    string
+}
+
+// NewUnitRecruited creates the event raised when the unit has been stored
+func NewUnitRecruited(unit *UnitEntity) *UnitRecruited {
+	return &UnitRecruited{Base: events.NewBase(unit.GetId()), UnitId: unit.GetId(), Vocation: unit.GetVocation(), Faction: unit.GetFaction(), Level: unit.GetLevel()}
+}
+
+// Type implements events.Event
+func (e *UnitRecruited) Type() string { return UnitRecruitedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *UnitRecruited) Data() any {
+	return &UnitRecruitedPayload{UnitId: e.UnitId, Vocation: e.Vocation, Faction: e.Faction, Level: e.Level}
+}
+
+// UnitAssignedToCharacter is raised when a unit has been linked to a character
+type UnitAssignedToCharacter struct {
+	events.Base
+	UnitId      string
+	CharacterId string
+}
+
+// NewUnitAssignedToCharacter creates the event raised when the unit has been linked to the character
+func NewUnitAssignedToCharacter(unitId, characterId string) *UnitAssignedToCharacter {
+	return &UnitAssignedToCharacter{Base: events.NewBase(unitId), UnitId: unitId, CharacterId: characterId}
+}
+
+// Type implements events.Event
+func (e *UnitAssignedToCharacter) Type() string { return UnitAssignedToCharacterEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *UnitAssignedToCharacter) Data() any {
+	return &UnitAssignedToCharacterPayload{UnitId: e.UnitId, CharacterId: e.CharacterId}
+}
+
+// RegisterEvents registers the payloads of the events of the domain
+func RegisterEvents(registry *events.Registry) error {
+	return errors.Join(
+		registry.Register(UnitRecruitedEvent, 1, &UnitRecruitedPayload{}),
+		registry.Register(UnitAssignedToCharacterEvent, 1, &UnitAssignedToCharacterPayload{}),
+	)
+}
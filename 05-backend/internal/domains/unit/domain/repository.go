@@ -5,4 +5,6 @@ import "context"
 // UnitRepository representation of a unit repository
 type UnitRepository interface {
 	GetById(ctx context.Context, id string) (*UnitEntity, error)
+	GetByIds(ctx context.Context, ids ...string) ([]*UnitEntity, error)
+	CreateForCharacter(ctx context.Context, characterId string, entities ...*UnitEntity) error
 }
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The limits of the name of a unit
+const (
+	MinNameLength = 3
+	MaxNameLength = 32
+)
+
+// RecruitLevel is the level every unit starts at once it is recruited
+const RecruitLevel = "1"
+
+// NormalizeName returns the name trimmed, or ErrInvalidName when it is too short or too long,
+// or contains other characters than letters, digits, spaces, underscores, dashes and dots
+func NormalizeName(name string) (string, error) {
+	normalized := strings.TrimSpace(name)
+
+	length := utf8.RuneCountInString(normalized)
+	if length < MinNameLength || length > MaxNameLength {
+		return "", fmt.Errorf("%w: should be between %d and %d characters", ErrInvalidName, MinNameLength, MaxNameLength)
+	}
+
+	for _, character := range normalized {
+		if !unicode.IsLetter(character) && !unicode.IsDigit(character) && !strings.ContainsRune(" _-.", character) {
+			return "", fmt.Errorf("%w: contains '%c'", ErrInvalidName, character)
+		}
+	}
+	return normalized, nil
+}
+
+// ValidateVocation returns ErrInvalidVocation when the vocation is none or unknown
+func ValidateVocation(vocation Vocation) error {
+	if _, known := Vocation_name[int32(vocation)]; !known || vocation == Vocation_VOCATION_NONE {
+		return fmt.Errorf("%w: '%s'", ErrInvalidVocation, vocation)
+	}
+	return nil
+}
+
+// ValidateFaction returns ErrInvalidFaction when the faction is none or unknown
+func ValidateFaction(faction types.Faction) error {
+	if _, known := types.Faction_name[int32(faction)]; !known || faction == types.Faction_FACTION_NONE {
+		return fmt.Errorf("%w: '%s'", ErrInvalidFaction, faction)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/types"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Unit Rules", func() {
+	Context("When a name is normalized", func() {
+		It("should trim it", func() {
+			Expect(NormalizeName("  Sir Lance-lot ")).To(Equal("Sir Lance-lot"))
+		})
+
+		It("should refuse names that are too short, too long or contain symbols", func() {
+			for _, name := range []string{"", "  ab ", strings.Repeat("a", MaxNameLength+1), "<script>"} {
+				_, err := NormalizeName(name)
+				Expect(err).To(MatchError(ErrInvalidName), "expected '%s' to be refused", name)
+			}
+		})
+	})
+
+	Context("When a vocation is validated", func() {
+		It("should accept every vocation but none", func() {
+			for value, name := range Vocation_name {
+				if Vocation(value) == Vocation_VOCATION_NONE {
+					continue
+				}
+				Expect(ValidateVocation(Vocation(value))).To(Succeed(), "expected '%s' to be accepted", name)
+			}
+		})
+
+		It("should refuse none and unknown vocations", func() {
+			Expect(ValidateVocation(Vocation_VOCATION_NONE)).To(MatchError(ErrInvalidVocation))
+			Expect(ValidateVocation(Vocation(99))).To(MatchError(ErrInvalidVocation))
+		})
+	})
+
+	Context("When a faction is validated", func() {
+		It("should accept the factions a unit can belong to", func() {
+			Expect(ValidateFaction(types.Faction_FACTION_HUMAN)).To(Succeed())
+			Expect(ValidateFaction(types.Faction_FACTION_ORC)).To(Succeed())
+		})
+
+		It("should refuse none and unknown factions", func() {
+			Expect(ValidateFaction(types.Faction_FACTION_NONE)).To(MatchError(ErrInvalidFaction))
+			Expect(ValidateFaction(types.Faction(99))).To(MatchError(ErrInvalidFaction))
+		})
+	})
+})
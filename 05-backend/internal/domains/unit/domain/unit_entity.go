@@ -0,0 +1,23 @@
+package domain
+
+import "shvdg/crazed-conquerer/internal/shared/types"
+
+// ToUnit converts the stored unit into the unit shown to players, unknown vocations and factions become NONE
+func (x *UnitEntity) ToUnit() *Unit {
+	return &Unit{
+		Id:       x.GetId(),
+		Name:     x.GetName(),
+		Level:    x.GetLevel(),
+		Vocation: Vocation(Vocation_value[x.GetVocation()]),
+		Faction:  types.Faction(types.Faction_value[x.GetFaction()]),
+	}
+}
+
+// NewUnitList converts the stored units into a list of units shown to players
+func NewUnitList(entities []*UnitEntity) *UnitList {
+	units := make([]*Unit, len(entities))
+	for i, entity := range entities {
+		units[i] = entity.ToUnit()
+	}
+	return &UnitList{Units: units}
+}
@@ -2,19 +2,23 @@ package infrastructure
 
 import (
 	"context"
+	characterunitDomain "shvdg/crazed-conquerer/internal/domains/character-unit/domain"
+	characterunitinfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
 	"shvdg/crazed-conquerer/internal/domains/unit/domain"
 	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
 	"shvdg/crazed-conquerer/internal/shared/sql"
 )
 
 // UnitRepositoryImpl provides the concrete implementation of the UnitRepository interface
 type UnitRepositoryImpl struct {
 	database.Connection
+	recorder events.Recorder
 }
 
-// NewUnitRepositoryImpl creates a new instance of UnitRepositoryImpl
-func NewUnitRepositoryImpl(connection database.Connection) *UnitRepositoryImpl {
-	return &UnitRepositoryImpl{connection}
+// NewUnitRepositoryImpl creates a new instance of UnitRepositoryImpl, the recorders receive the events it raises
+func NewUnitRepositoryImpl(connection database.Connection, recorders ...events.Recorder) *UnitRepositoryImpl {
+	return &UnitRepositoryImpl{Connection: connection, recorder: events.Recorders(recorders)}
 }
 
 // GetById retrieves a unit by their ID
@@ -28,6 +32,26 @@ func (s *UnitRepositoryImpl) GetById(ctx context.Context, id string) (*domain.Un
 	return s.ReadOne(ctx, query, args, ScanUnitEntity)
 }
 
+// GetByIds retrieves the units with the given IDs, IDs without a unit are left out
+func (s *UnitRepositoryImpl) GetByIds(ctx context.Context, ids ...string) ([]*domain.UnitEntity, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	values := make([]any, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+
+	query, args := sql.NewQuery().
+		Select(FieldId, FieldVocation, FieldFaction, FieldName, FieldLevel, FieldCreatedAt, FieldUpdatedAt).
+		From(TableName).
+		WhereIn(FieldId, values...).
+		Build()
+
+	return s.ReadMany(ctx, query, args, ScanUnitEntity)
+}
+
 // Create inserts one or more unit entities into the database
 func (s *UnitRepositoryImpl) Create(ctx context.Context, entities ...*domain.UnitEntity) error {
 	if len(entities) == 0 {
@@ -45,7 +69,34 @@ func (s *UnitRepositoryImpl) Create(ctx context.Context, entities ...*domain.Uni
 		BatchValues(argSets).
 		BuildBatch()
 
-	return database.Batch(ctx, s.Connection, query, batchArgs)
+	if err := database.Batch(ctx, s.Connection, query, batchArgs); err != nil {
+		return err
+	}
+
+	raised := make([]events.Event, len(entities))
+	for i, entity := range entities {
+		raised[i] = domain.NewUnitRecruited(entity)
+	}
+	return s.recorder.Record(ctx, raised...)
+}
+
+// CreateForCharacter inserts one or more unit entities and assigns them to the character within a single transaction
+func (s *UnitRepositoryImpl) CreateForCharacter(ctx context.Context, characterId string, entities ...*domain.UnitEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	characterUnits := make([]*characterunitDomain.CharacterUnitEntity, len(entities))
+	for i, entity := range entities {
+		characterUnits[i] = &characterunitDomain.CharacterUnitEntity{CharacterId: characterId, UnitId: entity.GetId()}
+	}
+
+	return database.InTransaction(ctx, s.Connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		if err := s.Create(ctx, entities...); err != nil {
+			return err
+		}
+		return characterunitinfra.NewCharacterUnitRepositoryImpl(s.Connection, s.recorder).Create(ctx, characterUnits...)
+	})
 }
 
 // Update modifies one or more unit entities in the database
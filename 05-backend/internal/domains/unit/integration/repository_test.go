@@ -21,6 +21,7 @@ var _ = Describe("Unit Repository", Ordered, func() {
 	var ctx context.Context
 
 	var suite *testing.Suite
+	var recorder *testing.EventRecorder
 	var unitRepo *infa.UnitRepositoryImpl
 
 	BeforeAll(func() {
@@ -29,7 +30,8 @@ var _ = Describe("Unit Repository", Ordered, func() {
 		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
 
 		ctx = contexts.SetTransaction(suite.Context, transaction)
-		unitRepo = infa.NewUnitRepositoryImpl(suite.Database)
+		recorder = testing.NewEventRecorder()
+		unitRepo = infa.NewUnitRepositoryImpl(suite.Database, recorder)
 	})
 
 	AfterAll(func() {
@@ -57,6 +59,13 @@ var _ = Describe("Unit Repository", Ordered, func() {
 			Expect(err).ToNot(HaveOccurred(), "failed to count units")
 			Expect(count).To(Equal(1), "expected 1 unit to be created")
 		})
+
+		It("should raise a UnitRecruited event", func() {
+			raised := recorder.OfType(domain.UnitRecruitedEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 UnitRecruited event")
+			Expect(raised[0].AggregateID()).To(Equal(unit.GetId()))
+			Expect(raised[0].(*domain.UnitRecruited).Vocation).To(Equal(unit.GetVocation()))
+		})
 	})
 
 	Context("When retrieving a unit by ID", func() {
@@ -78,6 +87,29 @@ var _ = Describe("Unit Repository", Ordered, func() {
 		})
 	})
 
+	Context("When retrieving units by IDs", func() {
+		var first, second *domain.UnitEntity
+
+		BeforeAll(func() {
+			first = domain.NewUnitEntity().WithDefaults().Build()
+			second = domain.NewUnitEntity().WithDefaults().Build()
+			err := unitRepo.Create(ctx, first, second)
+			Expect(err).ToNot(HaveOccurred(), "failed to create units")
+		})
+
+		It("should return the units with the given IDs", func() {
+			found, err := unitRepo.GetByIds(ctx, first.GetId(), second.GetId(), "unknown-id")
+			Expect(err).ToNot(HaveOccurred(), "failed to get units by IDs")
+			Expect(found).To(HaveLen(2))
+		})
+
+		It("should return no units when no IDs are given", func() {
+			found, err := unitRepo.GetByIds(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to get units by IDs")
+			Expect(found).To(BeEmpty())
+		})
+	})
+
 	Context("When one unit is updated", func() {
 		var unit *domain.UnitEntity
 
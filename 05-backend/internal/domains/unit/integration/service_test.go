@@ -0,0 +1,106 @@
+package integration
+
+import (
+	"context"
+	characterUnitInfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
+	"shvdg/crazed-conquerer/internal/domains/unit/application"
+	"shvdg/crazed-conquerer/internal/domains/unit/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/unit/infrastructure"
+	userCharacterApplication "shvdg/crazed-conquerer/internal/domains/user-character/application"
+	userCharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+	userCharacterInfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/fixtures"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"shvdg/crazed-conquerer/internal/shared/types"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Unit Service", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var graph, other *fixtures.Graph
+	var unitService *application.UnitService
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+
+		graph, err = fixtures.NewGraph().WithUnitsPerCharacter(3).Create(ctx, suite.Database)
+		Expect(err).ToNot(HaveOccurred(), "failed to create graph")
+		other, err = fixtures.NewGraph().WithUnitsPerCharacter(1).Create(ctx, suite.Database)
+		Expect(err).ToNot(HaveOccurred(), "failed to create other graph")
+
+		owners := userCharacterApplication.NewUserCharacterService(userCharacterInfra.NewUserCharacterRepositoryImpl(suite.Database))
+		unitService = application.NewUnitService(infra.NewUnitRepositoryImpl(suite.Database),
+			characterUnitInfra.NewCharacterUnitRepositoryImpl(suite.Database), owners)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When the units of a character are retrieved", func() {
+		It("should return the units of the character", func() {
+			units, err := unitService.GetCharacterUnits(ctx, graph.User.GetId(), graph.Characters[0].Character.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get units")
+			Expect(units).To(HaveLen(3))
+
+			list := domain.NewUnitList(units)
+			Expect(list.GetUnits()).To(HaveLen(3))
+			Expect(list.GetUnits()[0].GetVocation()).ToNot(Equal(domain.Vocation_VOCATION_NONE))
+		})
+
+		It("should refuse a character of another user", func() {
+			_, err := unitService.GetCharacterUnits(ctx, graph.User.GetId(), other.Characters[0].Character.GetId())
+			Expect(err).To(MatchError(userCharacterDomain.ErrCharacterNotOwned))
+		})
+	})
+
+	Context("When a unit is retrieved", func() {
+		It("should return a unit of the user", func() {
+			unitId := graph.Characters[0].UnitIds()[0]
+			unit, err := unitService.GetUnit(ctx, graph.User.GetId(), unitId)
+			Expect(err).ToNot(HaveOccurred(), "failed to get unit")
+			Expect(unit.GetId()).To(Equal(unitId))
+		})
+
+		It("should refuse a unit of another user", func() {
+			_, err := unitService.GetUnit(ctx, graph.User.GetId(), other.Characters[0].UnitIds()[0])
+			Expect(err).To(MatchError(domain.ErrUnitNotOwned))
+		})
+	})
+
+	Context("When a unit is recruited", func() {
+		It("should create it at the recruit level for the character", func() {
+			characterId := graph.Characters[0].Character.GetId()
+			unit, err := unitService.RecruitUnit(ctx, graph.User.GetId(), characterId, "Recruit", domain.Vocation_VOCATION_MINER, types.Faction_FACTION_ORC)
+			Expect(err).ToNot(HaveOccurred(), "failed to recruit unit")
+			Expect(unit.GetLevel()).To(Equal(domain.RecruitLevel))
+
+			units, err := unitService.GetCharacterUnits(ctx, graph.User.GetId(), characterId)
+			Expect(err).ToNot(HaveOccurred(), "failed to get units")
+			Expect(units).To(ContainElement(HaveField("Id", unit.GetId())))
+		})
+
+		It("should refuse a character of another user", func() {
+			_, err := unitService.RecruitUnit(ctx, graph.User.GetId(), other.Characters[0].Character.GetId(), "Recruit", domain.Vocation_VOCATION_MINER, types.Faction_FACTION_ORC)
+			Expect(err).To(MatchError(userCharacterDomain.ErrCharacterNotOwned))
+		})
+
+		It("should refuse a unit without a vocation", func() {
+			_, err := unitService.RecruitUnit(ctx, graph.User.GetId(), graph.Characters[0].Character.GetId(), "Recruit", domain.Vocation_VOCATION_NONE, types.Faction_FACTION_ORC)
+			Expect(err).To(MatchError(domain.ErrInvalidVocation))
+		})
+	})
+})
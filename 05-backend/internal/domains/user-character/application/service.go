@@ -1 +1,60 @@
 package application
+
+import (
+	"context"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/user-character/domain"
+)
+
+// UserCharacterService tells which characters belong to which users
+type UserCharacterService struct {
+	userCharacters domain.UserCharacterRepository
+}
+
+// NewUserCharacterService instantiates a new UserCharacterService instance
+func NewUserCharacterService(userCharacters domain.UserCharacterRepository) *UserCharacterService {
+	return &UserCharacterService{userCharacters: userCharacters}
+}
+
+// GetCharacterIds returns the ids of the characters that belong to the user
+func (s *UserCharacterService) GetCharacterIds(ctx context.Context, userId string) ([]string, error) {
+	userCharacters, err := s.userCharacters.GetByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get characters of user '%s': %w", userId, err)
+	}
+
+	characterIds := make([]string, len(userCharacters))
+	for i, userCharacter := range userCharacters {
+		characterIds[i] = userCharacter.GetCharacterId()
+	}
+	return characterIds, nil
+}
+
+// VerifyOwner returns ErrCharacterNotOwned when the character does not belong to the user
+func (s *UserCharacterService) VerifyOwner(ctx context.Context, userId, characterId string) error {
+	owned, err := s.OwnsAny(ctx, userId, characterId)
+	if err != nil {
+		return err
+	}
+	if !owned {
+		return domain.ErrCharacterNotOwned
+	}
+	return nil
+}
+
+// OwnsAny returns whether at least one of the characters belongs to the user
+func (s *UserCharacterService) OwnsAny(ctx context.Context, userId string, characterIds ...string) (bool, error) {
+	owned, err := s.GetCharacterIds(ctx, userId)
+	if err != nil {
+		return false, err
+	}
+
+	for _, ownedId := range owned {
+		for _, characterId := range characterIds {
+			if ownedId == characterId {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
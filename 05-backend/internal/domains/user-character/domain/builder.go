@@ -1,17 +1,24 @@
 package domain
 
 import (
-	"github.com/google/uuid"
+	"shvdg/crazed-conquerer/internal/shared/faker"
 )
 
 // UserCharacterEntityBuilder helps build and configure a UserCharacterEntity object.
 type UserCharacterEntityBuilder struct {
 	userCharacterEntity *UserCharacterEntity
+	faker               *faker.Faker
 }
 
 // NewUserCharacterEntity initializes a new UserCharacterEntityBuilder with empty values.
 func NewUserCharacterEntity() *UserCharacterEntityBuilder {
-	return &UserCharacterEntityBuilder{userCharacterEntity: &UserCharacterEntity{}}
+	return &UserCharacterEntityBuilder{userCharacterEntity: &UserCharacterEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *UserCharacterEntityBuilder) WithFaker(faker *faker.Faker) *UserCharacterEntityBuilder {
+	b.faker = faker
+	return b
 }
 
 // WithUserID sets the user ID of the user character entity.
@@ -22,7 +29,7 @@ func (b *UserCharacterEntityBuilder) WithUserID(userID string) *UserCharacterEnt
 
 // WithRandomUserID sets a random user ID (UUID) for the user character entity.
 func (b *UserCharacterEntityBuilder) WithRandomUserID() *UserCharacterEntityBuilder {
-	b.userCharacterEntity.UserId = uuid.New().String()
+	b.userCharacterEntity.UserId = b.faker.UUID()
 	return b
 }
 
@@ -34,7 +41,7 @@ func (b *UserCharacterEntityBuilder) WithCharacterID(characterID string) *UserCh
 
 // WithRandomCharacterID sets a random character ID (UUID) for the user character entity.
 func (b *UserCharacterEntityBuilder) WithRandomCharacterID() *UserCharacterEntityBuilder {
-	b.userCharacterEntity.CharacterId = uuid.New().String()
+	b.userCharacterEntity.CharacterId = b.faker.UUID()
 	return b
 }
 
@@ -0,0 +1,6 @@
+package domain
+
+import "errors"
+
+// ErrCharacterNotOwned is returned when a character does not belong to the user
+var ErrCharacterNotOwned = errors.New("character does not belong to the user")
@@ -0,0 +1,12 @@
+package infrastructure
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+)
+
+// Migrations returns the versioned changes to the user_characters table
+func Migrations() migrations.Domain {
+	return migrations.NewDomain(TableName, []string{"users", "characters"},
+		migrations.NewMigration(1, "create user_characters table", CreateTableQuery, DropTableQuery),
+	)
+}
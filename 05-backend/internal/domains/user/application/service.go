@@ -1 +1,214 @@
 package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/user/domain"
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserService handles user-related operations
+type UserService struct {
+	users    domain.UserRepository
+	hasher   *passwords.Hasher
+	recorder events.Recorder
+}
+
+// NewUserService instantiates a new UserService instance, the recorders receive the events it raises
+func NewUserService(users domain.UserRepository, hasher *passwords.Hasher, recorders ...events.Recorder) *UserService {
+	return &UserService{users: users, hasher: hasher, recorder: events.Recorders(recorders)}
+}
+
+// Register creates a user with a normalized email address and display name and a hashed password.
+// The repository raises UserRegistered. ErrInvalidEmail, ErrEmailTaken, ErrWeakPassword and
+// ErrInvalidDisplayName are returned when the details break the rules for accounts.
+func (s *UserService) Register(ctx context.Context, email, password, displayName string) (*domain.UserEntity, error) {
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidatePassword(password, email); err != nil {
+		return nil, err
+	}
+	displayName, err = domain.NormalizeDisplayName(displayName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.users.GetByEmail(ctx, email)
+	if err == nil {
+		return nil, domain.ErrEmailTaken
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, fmt.Errorf("failed to check email: %w", err)
+	}
+
+	hashed, err := s.hasher.Hash(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	now := converters.TimeToTimestamp(time.Now())
+	user := &domain.UserEntity{
+		Id:          uuid.NewString(),
+		Email:       email,
+		Password:    hashed,
+		DisplayName: displayName,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := s.users.Create(ctx, user); err != nil {
+		if errors.Is(err, database.ErrDuplicate) {
+			return nil, domain.ErrEmailTaken
+		}
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return user, nil
+}
+
+// Login returns the user when the password matches the one stored for the email, records the time of the login
+// and raises UserLoggedIn. A password hashed with outdated parameters is rehashed and stored again.
+// ErrInvalidCredentials is returned both for unknown emails and wrong passwords, which take about the same time to verify.
+func (s *UserService) Login(ctx context.Context, email, password string) (*domain.UserEntity, error) {
+	user, err := s.findByEmail(ctx, email)
+	if errors.Is(err, database.ErrNotFound) || errors.Is(err, domain.ErrInvalidEmail) {
+		_, _ = s.hasher.Hash(password)
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.verify(user, password); err != nil {
+		return nil, err
+	}
+
+	if s.hasher.NeedsRehash(user.GetPassword()) {
+		if err := s.storePassword(ctx, user, password); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	if err := s.users.UpdateLastLoginAt(ctx, user.GetId(), now); err != nil {
+		return nil, fmt.Errorf("failed to record login of user '%s': %w", user.GetId(), err)
+	}
+	user.LastLoginAt = converters.TimeToTimestamp(now)
+
+	if err := s.recorder.Record(ctx, domain.NewUserLoggedIn(user)); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUser returns the user with the id
+func (s *UserService) GetUser(ctx context.Context, userId string) (*domain.UserEntity, error) {
+	user, err := s.users.GetById(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user '%s': %w", userId, err)
+	}
+	return user, nil
+}
+
+// ChangePassword replaces the password of the user once the current password is verified, raising UserPasswordChanged.
+// ErrInvalidCredentials is returned when the current password does not match, ErrWeakPassword when the new one is refused.
+func (s *UserService) ChangePassword(ctx context.Context, userId, currentPassword, newPassword string) error {
+	user, err := s.users.GetById(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.verify(user, currentPassword); err != nil {
+		return err
+	}
+	if err := domain.ValidatePassword(newPassword, user.GetEmail()); err != nil {
+		return err
+	}
+
+	if err := s.storePassword(ctx, user, newPassword); err != nil {
+		return err
+	}
+	return s.recorder.Record(ctx, domain.NewUserPasswordChanged(user))
+}
+
+// ResetPassword replaces the password of the user with the email address without verifying the current one, raising
+// UserPasswordChanged. It is meant for administrators, ErrWeakPassword is returned when the new password is refused.
+func (s *UserService) ResetPassword(ctx context.Context, email, newPassword string) (*domain.UserEntity, error) {
+	user, err := s.findByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user '%s': %w", email, err)
+	}
+
+	if err := domain.ValidatePassword(newPassword, user.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	if err := s.storePassword(ctx, user, newPassword); err != nil {
+		return nil, err
+	}
+	if err := s.recorder.Record(ctx, domain.NewUserPasswordChanged(user)); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// DeleteAccount deletes the user once the password is verified, raising UserDeleted.
+// ErrInvalidCredentials is returned when the password does not match.
+func (s *UserService) DeleteAccount(ctx context.Context, userId, password string) error {
+	user, err := s.users.GetById(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.verify(user, password); err != nil {
+		return err
+	}
+
+	if err := s.users.Delete(ctx, user); err != nil {
+		return fmt.Errorf("failed to delete user '%s': %w", user.GetId(), err)
+	}
+	return s.recorder.Record(ctx, domain.NewUserDeleted(user))
+}
+
+// findByEmail retrieves the user by their normalized email address
+func (s *UserService) findByEmail(ctx context.Context, email string) (*domain.UserEntity, error) {
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	return s.users.GetByEmail(ctx, email)
+}
+
+// verify returns ErrInvalidCredentials when the password does not match the one stored for the user
+func (s *UserService) verify(user *domain.UserEntity, password string) error {
+	matches, err := s.hasher.Verify(password, user.GetPassword())
+	if err != nil {
+		return fmt.Errorf("failed to verify password of user '%s': %w", user.GetId(), err)
+	}
+	if !matches {
+		return domain.ErrInvalidCredentials
+	}
+	return nil
+}
+
+// storePassword stores the password of the user hashed with the current parameters of the hasher
+func (s *UserService) storePassword(ctx context.Context, user *domain.UserEntity, password string) error {
+	hashed, err := s.hasher.Hash(password)
+	if err != nil {
+		return fmt.Errorf("failed to hash password of user '%s': %w", user.GetId(), err)
+	}
+
+	user.Password = hashed
+	if err := s.users.Update(ctx, user); err != nil {
+		return fmt.Errorf("failed to store password of user '%s': %w", user.GetId(), err)
+	}
+	return nil
+}
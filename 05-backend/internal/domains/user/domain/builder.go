@@ -3,16 +3,15 @@ package domain
 import (
 	"fmt"
 	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/faker"
 	"time"
-
-	fake "github.com/brianvoe/gofakeit/v6"
-	"github.com/google/uuid"
 )
 
 // UserEntityBuilder helps build and configure a UserEntity object.
 type UserEntityBuilder struct {
 	userEntity *UserEntity
 	counter    uint64
+	faker      *faker.Faker
 }
 
 // GetNumber returns the current number of users.
@@ -22,7 +21,13 @@ func (b *UserEntityBuilder) GetNumber() uint64 {
 
 // NewUserEntity initializes a new UserEntityBuilder with empty values.
 func NewUserEntity() *UserEntityBuilder {
-	return &UserEntityBuilder{userEntity: &UserEntity{}}
+	return &UserEntityBuilder{userEntity: &UserEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *UserEntityBuilder) WithFaker(faker *faker.Faker) *UserEntityBuilder {
+	b.faker = faker
+	return b
 }
 
 // WithId sets the ID of the user entity.
@@ -33,7 +38,7 @@ func (b *UserEntityBuilder) WithId(id string) *UserEntityBuilder {
 
 // WithRandomId sets a random ID (UUID) for the user entity.
 func (b *UserEntityBuilder) WithRandomId() *UserEntityBuilder {
-	b.userEntity.Id = uuid.New().String()
+	b.userEntity.Id = b.faker.UUID()
 	return b
 }
 
@@ -45,7 +50,7 @@ func (b *UserEntityBuilder) WithEmail(email string) *UserEntityBuilder {
 
 // WithRandomEmail sets a random email for the user entity.
 func (b *UserEntityBuilder) WithRandomEmail() *UserEntityBuilder {
-	b.userEntity.Email = fmt.Sprintf("user%d@%s", b.counter, fake.DomainName())
+	b.userEntity.Email = fmt.Sprintf("user%d@%s", b.counter, b.faker.DomainName())
 	return b
 }
 
@@ -57,7 +62,7 @@ func (b *UserEntityBuilder) WithPassword(password string) *UserEntityBuilder {
 
 // WithRandomPassword sets a random password for the user entity.
 func (b *UserEntityBuilder) WithRandomPassword() *UserEntityBuilder {
-	b.userEntity.Password = fake.Password(true, true, true, true, true, 8)
+	b.userEntity.Password = b.faker.Password(true, true, true, true, true, 8)
 	return b
 }
 
@@ -69,7 +74,7 @@ func (b *UserEntityBuilder) WithDisplayName(name string) *UserEntityBuilder {
 
 // WithRandomDisplayName sets a random display name for the user entity.
 func (b *UserEntityBuilder) WithRandomDisplayName() *UserEntityBuilder {
-	b.userEntity.DisplayName = fmt.Sprintf("%s_%d", fake.Username(), b.counter)
+	b.userEntity.DisplayName = fmt.Sprintf("%s_%d", b.faker.Username(), b.counter)
 	return b
 }
 
@@ -93,7 +98,7 @@ func (b *UserEntityBuilder) WithUpdatedAt(t time.Time) *UserEntityBuilder {
 
 // WithDefaults populates all fields with random default values.
 func (b *UserEntityBuilder) WithDefaults() *UserEntityBuilder {
-	b.counter = NextUserNumber()
+	b.counter = b.faker.Next("user")
 
 	now := time.Now()
 	return b.WithRandomId().
@@ -0,0 +1,14 @@
+package domain
+
+import "errors"
+
+// Errors returned when the details of a user break one of the rules for accounts
+var (
+	ErrInvalidEmail       = errors.New("email address is invalid")
+	ErrEmailTaken         = errors.New("email address is already registered")
+	ErrWeakPassword       = errors.New("password does not meet the password policy")
+	ErrInvalidDisplayName = errors.New("display name is invalid")
+)
+
+// ErrInvalidCredentials is returned when the email is unknown or the password does not match it
+var ErrInvalidCredentials = errors.New("invalid email or password")
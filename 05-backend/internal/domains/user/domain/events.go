@@ -1 +1,103 @@
 package domain
+
+import (
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/events"
+)
+
+// The types of the events raised by the user domain
+const (
+	UserRegisteredEvent      = "user.registered"
+	UserLoggedInEvent        = "user.logged_in"
+	UserPasswordChangedEvent = "user.password_changed"
+	UserDeletedEvent         = "user.deleted"
+)
+
+// UserRegistered is raised when a new user has been stored
+type UserRegistered struct {
+	events.Base
+	UserId      string
+	Email       string
+	DisplayName string
+}
+
+// NewUserRegistered creates the event raised when the user has been stored
+func NewUserRegistered(user *UserEntity) *UserRegistered {
+	return &UserRegistered{Base: events.NewBase(user.GetId()), UserId: user.GetId(), Email: user.GetEmail(), DisplayName: user.GetDisplayName()}
+}
+
+// Type implements events.Event
+func (e *UserRegistered) Type() string { return UserRegisteredEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *UserRegistered) Data() any {
+	return &UserRegisteredPayload{UserId: e.UserId, Email: e.Email, DisplayName: e.DisplayName}
+}
+
+// UserLoggedIn is raised when a user has logged in with valid credentials
+type UserLoggedIn struct {
+	events.Base
+	UserId string
+}
+
+// NewUserLoggedIn creates the event raised when the user has logged in
+func NewUserLoggedIn(user *UserEntity) *UserLoggedIn {
+	return &UserLoggedIn{Base: events.NewBase(user.GetId()), UserId: user.GetId()}
+}
+
+// Type implements events.Event
+func (e *UserLoggedIn) Type() string { return UserLoggedInEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *UserLoggedIn) Data() any {
+	return &UserLoggedInPayload{UserId: e.UserId}
+}
+
+// UserPasswordChanged is raised when a user has replaced their password
+type UserPasswordChanged struct {
+	events.Base
+	UserId string
+}
+
+// NewUserPasswordChanged creates the event raised when the user has replaced their password
+func NewUserPasswordChanged(user *UserEntity) *UserPasswordChanged {
+	return &UserPasswordChanged{Base: events.NewBase(user.GetId()), UserId: user.GetId()}
+}
+
+// Type implements events.Event
+func (e *UserPasswordChanged) Type() string { return UserPasswordChangedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *UserPasswordChanged) Data() any {
+	return &UserPasswordChangedPayload{UserId: e.UserId}
+}
+
+// UserDeleted is raised when a user has deleted their account
+type UserDeleted struct {
+	events.Base
+	UserId string
+	Email  string
+}
+
+// NewUserDeleted creates the event raised when the user has deleted their account
+func NewUserDeleted(user *UserEntity) *UserDeleted {
+	return &UserDeleted{Base: events.NewBase(user.GetId()), UserId: user.GetId(), Email: user.GetEmail()}
+}
+
+// Type implements events.Event
+func (e *UserDeleted) Type() string { return UserDeletedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *UserDeleted) Data() any {
+	return &UserDeletedPayload{UserId: e.UserId, Email: e.Email}
+}
+
+// RegisterEvents registers the payloads of the events of the domain
+func RegisterEvents(registry *events.Registry) error {
+	return errors.Join(
+		registry.Register(UserRegisteredEvent, 1, &UserRegisteredPayload{}),
+		registry.Register(UserLoggedInEvent, 1, &UserLoggedInPayload{}),
+		registry.Register(UserPasswordChangedEvent, 1, &UserPasswordChangedPayload{}),
+		registry.Register(UserDeletedEvent, 1, &UserDeletedPayload{}),
+	)
+}
@@ -1,9 +1,16 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // UserRepository representation of a user repository
 type UserRepository interface {
+	GetById(ctx context.Context, id string) (*UserEntity, error)
 	GetByEmail(ctx context.Context, email string) (*UserEntity, error)
-	Authenticate(ctx context.Context, email, password string) (*UserEntity, error)
+	Create(ctx context.Context, entities ...*UserEntity) error
+	Update(ctx context.Context, entities ...*UserEntity) error
+	UpdateLastLoginAt(ctx context.Context, id string, lastLoginAt time.Time) error
+	Delete(ctx context.Context, entities ...*UserEntity) error
 }
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The limits of the details of a user
+const (
+	MaxEmailLength       = 255
+	MinPasswordLength    = 8
+	MaxPasswordLength    = 128
+	MinDisplayNameLength = 3
+	MaxDisplayNameLength = 32
+)
+
+// NormalizeEmail returns the email address trimmed and in lower case, or ErrInvalidEmail when it is not a bare address
+func NormalizeEmail(email string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(email))
+	if len(normalized) > MaxEmailLength {
+		return "", fmt.Errorf("%w: longer than %d characters", ErrInvalidEmail, MaxEmailLength)
+	}
+
+	address, err := mail.ParseAddress(normalized)
+	if err != nil || address.Address != normalized || address.Name != "" {
+		return "", fmt.Errorf("%w: '%s'", ErrInvalidEmail, email)
+	}
+	return normalized, nil
+}
+
+// ValidatePassword returns ErrWeakPassword when the password is too short or too long, contains no letter or
+// no digit, or equals the email address
+func ValidatePassword(password, email string) error {
+	length := utf8.RuneCountInString(password)
+	if length < MinPasswordLength {
+		return fmt.Errorf("%w: shorter than %d characters", ErrWeakPassword, MinPasswordLength)
+	}
+	if length > MaxPasswordLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrWeakPassword, MaxPasswordLength)
+	}
+	if !strings.ContainsFunc(password, unicode.IsLetter) || !strings.ContainsFunc(password, unicode.IsDigit) {
+		return fmt.Errorf("%w: should contain both letters and digits", ErrWeakPassword)
+	}
+	if strings.EqualFold(password, email) {
+		return fmt.Errorf("%w: should not equal the email address", ErrWeakPassword)
+	}
+	return nil
+}
+
+// NormalizeDisplayName returns the display name trimmed, or ErrInvalidDisplayName when it is too short or too long,
+// or contains other characters than letters, digits, spaces, underscores, dashes and dots
+func NormalizeDisplayName(displayName string) (string, error) {
+	normalized := strings.TrimSpace(displayName)
+
+	length := utf8.RuneCountInString(normalized)
+	if length < MinDisplayNameLength || length > MaxDisplayNameLength {
+		return "", fmt.Errorf("%w: should be between %d and %d characters", ErrInvalidDisplayName, MinDisplayNameLength, MaxDisplayNameLength)
+	}
+
+	for _, character := range normalized {
+		if !unicode.IsLetter(character) && !unicode.IsDigit(character) && !strings.ContainsRune(" _-.", character) {
+			return "", fmt.Errorf("%w: contains '%c'", ErrInvalidDisplayName, character)
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Rules", func() {
+	Context("When an email address is normalized", func() {
+		It("should trim it and turn it into lower case", func() {
+			Expect(NormalizeEmail("  Player@Example.COM ")).To(Equal("player@example.com"))
+		})
+
+		It("should refuse anything but a bare address", func() {
+			for _, email := range []string{"", "player", "player@", "Player <player@example.com>", "a b@example.com"} {
+				_, err := NormalizeEmail(email)
+				Expect(err).To(MatchError(ErrInvalidEmail), "expected '%s' to be refused", email)
+			}
+		})
+
+		It("should refuse addresses that are too long", func() {
+			_, err := NormalizeEmail(strings.Repeat("a", MaxEmailLength) + "@example.com")
+			Expect(err).To(MatchError(ErrInvalidEmail))
+		})
+	})
+
+	Context("When a password is validated", func() {
+		It("should accept passwords with letters and digits", func() {
+			Expect(ValidatePassword("correct horse 42", "player@example.com")).To(Succeed())
+		})
+
+		It("should refuse passwords that break the policy", func() {
+			for _, password := range []string{
+				"short1",
+				strings.Repeat("a1", MaxPasswordLength),
+				"onlyletters",
+				"1234567890",
+				"Player1@example.com",
+			} {
+				Expect(ValidatePassword(password, "player1@example.com")).To(MatchError(ErrWeakPassword), "expected '%s' to be refused", password)
+			}
+		})
+	})
+
+	Context("When a display name is normalized", func() {
+		It("should trim it", func() {
+			Expect(NormalizeDisplayName("  Sir_Lancelot-2.0 ")).To(Equal("Sir_Lancelot-2.0"))
+		})
+
+		It("should refuse names that break the rules", func() {
+			for _, displayName := range []string{"ab", "   ab   ", strings.Repeat("a", MaxDisplayNameLength+1), "<script>", "name\tname"} {
+				_, err := NormalizeDisplayName(displayName)
+				Expect(err).To(MatchError(ErrInvalidDisplayName), "expected '%s' to be refused", displayName)
+			}
+		})
+	})
+})
@@ -0,0 +1,14 @@
+package infrastructure
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+)
+
+// Migrations returns the versioned changes to the users table
+func Migrations() migrations.Domain {
+	return migrations.NewDomain(TableName, nil,
+		migrations.NewMigration(1, "create users table", CreateTableQuery, DropTableQuery),
+		// hashing the passwords is irreversible, reverting it keeps them hashed
+		migrations.NewMigration(2, "hash plaintext passwords", HashPlaintextPasswordsQuery, KeepHashedPasswordsQuery),
+	)
+}
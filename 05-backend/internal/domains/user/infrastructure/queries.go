@@ -28,4 +28,16 @@ const (
 	`
 
 	DropTableQuery = `DROP TABLE IF EXISTS ` + TableName + ` CASCADE;`
+
+	// HashPlaintextPasswordsQuery hashes passwords that were stored as plaintext with bcrypt,
+	// they are rehashed with the current algorithm once their users log in
+	HashPlaintextPasswordsQuery = `
+		CREATE EXTENSION IF NOT EXISTS pgcrypto;
+		UPDATE ` + TableName + ` SET ` + FieldPassword + ` = crypt(` + FieldPassword + `, gen_salt('bf', 10))
+		WHERE ` + FieldPassword + ` NOT LIKE '$argon2id$%' AND ` + FieldPassword + ` NOT LIKE '$2_$%';
+	`
+
+	// KeepHashedPasswordsQuery reverts HashPlaintextPasswordsQuery, which is irreversible: a hash cannot be turned back
+	// into its plaintext, so the passwords stay hashed and only the migration stops being tracked as applied
+	KeepHashedPasswordsQuery = `SELECT 1;`
 )
@@ -4,37 +4,40 @@ import (
 	"context"
 	"shvdg/crazed-conquerer/internal/domains/user/domain"
 	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
 	"shvdg/crazed-conquerer/internal/shared/sql"
+	"time"
 )
 
-// UserRepositoryImpl provides the concrete implementation of the UserRepository interface
+// UserRepositoryImpl provides the concrete implementation of the UserRepository interface.
+// Passwords are stored exactly as given, hashing them is up to the application layer.
 type UserRepositoryImpl struct {
 	database.Connection
+	recorder events.Recorder
 }
 
-// NewUserRepositoryImpl creates a new instance of UserRepositoryImpl
-func NewUserRepositoryImpl(connection database.Connection) *UserRepositoryImpl {
-	return &UserRepositoryImpl{connection}
+// NewUserRepositoryImpl creates a new instance of UserRepositoryImpl, the recorders receive the events it raises
+func NewUserRepositoryImpl(connection database.Connection, recorders ...events.Recorder) *UserRepositoryImpl {
+	return &UserRepositoryImpl{Connection: connection, recorder: events.Recorders(recorders)}
 }
 
-// GetByEmail retrieves a user by their email address
-func (s *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*domain.UserEntity, error) {
+// GetById retrieves a user by their id
+func (s *UserRepositoryImpl) GetById(ctx context.Context, id string) (*domain.UserEntity, error) {
 	query, args := sql.NewQuery().
 		Select(FieldId, FieldEmail, FieldPassword, FieldDisplayName, FieldCreatedAt, FieldUpdatedAt, FieldLastLoginAt).
 		From(TableName).
-		Where(FieldEmail, email).
+		Where(FieldId, id).
 		Build()
 
 	return s.ReadOne(ctx, query, args, ScanUserEntity)
 }
 
-// Authenticate validates user credentials and returns the user if valid
-func (s *UserRepositoryImpl) Authenticate(ctx context.Context, email, password string) (*domain.UserEntity, error) {
+// GetByEmail retrieves a user by their email address
+func (s *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*domain.UserEntity, error) {
 	query, args := sql.NewQuery().
 		Select(FieldId, FieldEmail, FieldPassword, FieldDisplayName, FieldCreatedAt, FieldUpdatedAt, FieldLastLoginAt).
 		From(TableName).
 		Where(FieldEmail, email).
-		Where(FieldPassword, password).
 		Build()
 
 	return s.ReadOne(ctx, query, args, ScanUserEntity)
@@ -56,7 +59,15 @@ func (s *UserRepositoryImpl) Create(ctx context.Context, entities ...*domain.Use
 		InsertFields(FieldId, FieldEmail, FieldPassword, FieldDisplayName).
 		BatchValues(argSets).
 		BuildBatch()
-	return database.Batch(ctx, s.Connection, query, batchArgs)
+	if err := database.Batch(ctx, s.Connection, query, batchArgs); err != nil {
+		return err
+	}
+
+	raised := make([]events.Event, len(entities))
+	for i, entity := range entities {
+		raised[i] = domain.NewUserRegistered(entity)
+	}
+	return s.recorder.Record(ctx, raised...)
 }
 
 // Update modifies one or more user entities in the database
@@ -79,6 +90,17 @@ func (s *UserRepositoryImpl) Update(ctx context.Context, entities ...*domain.Use
 	return database.Batch(ctx, s.Connection, query, batchArgs)
 }
 
+// UpdateLastLoginAt records when the user has last logged in
+func (s *UserRepositoryImpl) UpdateLastLoginAt(ctx context.Context, id string, lastLoginAt time.Time) error {
+	query, args := sql.NewQuery().
+		Update(TableName).
+		Set(FieldLastLoginAt, lastLoginAt).
+		Where(FieldId, id).
+		Build()
+
+	return database.Execute(ctx, s.Connection, query, args...)
+}
+
 // Upsert inserts or updates one or more user entities in the database
 func (s *UserRepositoryImpl) Upsert(ctx context.Context, entities ...*domain.UserEntity) error {
 	if len(entities) == 0 {
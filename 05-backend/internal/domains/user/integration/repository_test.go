@@ -2,6 +2,7 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"shvdg/crazed-conquerer/internal/domains/user/domain"
 	infra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
 	"shvdg/crazed-conquerer/internal/shared/contexts"
@@ -9,6 +10,7 @@ import (
 	"shvdg/crazed-conquerer/internal/shared/sql"
 	"shvdg/crazed-conquerer/internal/shared/testing"
 	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	. "github.com/onsi/ginkgo/v2"
@@ -21,6 +23,7 @@ var _ = Describe("User Repository", Ordered, func() {
 	var ctx context.Context
 
 	var suite *testing.Suite
+	var recorder *testing.EventRecorder
 	var userRepo *infra.UserRepositoryImpl
 
 	BeforeAll(func() {
@@ -29,7 +32,8 @@ var _ = Describe("User Repository", Ordered, func() {
 		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
 
 		ctx = contexts.SetTransaction(suite.Context, transaction)
-		userRepo = infra.NewUserRepositoryImpl(suite.Database)
+		recorder = testing.NewEventRecorder()
+		userRepo = infra.NewUserRepositoryImpl(suite.Database, recorder)
 	})
 
 	AfterAll(func() {
@@ -58,6 +62,12 @@ var _ = Describe("User Repository", Ordered, func() {
 			Expect(count).To(Equal(1), "expected 1 user to be created")
 		})
 
+		It("should raise a UserRegistered event", func() {
+			raised := recorder.OfType(domain.UserRegisteredEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 UserRegistered event")
+			Expect(raised[0].AggregateID()).To(Equal(user.GetId()))
+			Expect(raised[0].(*domain.UserRegistered).Email).To(Equal(user.GetEmail()))
+		})
 	})
 
 	Context("When retrieving a user by email", func() {
@@ -77,6 +87,81 @@ var _ = Describe("User Repository", Ordered, func() {
 			Expect(foundUser).ToNot(BeNil(), "expected to find a user")
 			Expect(foundUser.GetId()).To(Equal(user.GetId()))
 		})
+
+		It("should return ErrNotFound for an unknown email", func() {
+			_, err := userRepo.GetByEmail(ctx, "unknown@domain.com")
+			Expect(err).To(MatchError(database.ErrNotFound))
+		})
+	})
+
+	Context("When a user is created with an email that is taken", func() {
+		var user *domain.UserEntity
+
+		BeforeAll(func() {
+			user = domain.NewUserEntity().WithDefaults().Build()
+			err := userRepo.Create(ctx, user)
+			Expect(err).ToNot(HaveOccurred(), "failed to create user")
+		})
+
+		It("should return ErrDuplicate naming the email constraint", func() {
+			duplicate := domain.NewUserEntity().WithDefaults().WithEmail(user.GetEmail()).Build()
+			err := database.InTransaction(ctx, suite.Database, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+				return userRepo.Create(ctx, duplicate)
+			})
+			Expect(err).To(MatchError(database.ErrDuplicate))
+
+			var constraintErr *database.ConstraintError
+			Expect(errors.As(err, &constraintErr)).To(BeTrue(), "expected a ConstraintError")
+			Expect(constraintErr.Constraint).To(Equal(infra.TableName + "_" + infra.FieldEmail + "_key"))
+		})
+	})
+
+	Context("When a user is stored", func() {
+		var user *domain.UserEntity
+		var password string
+
+		BeforeAll(func() {
+			user = domain.NewUserEntity().WithDefaults().Build()
+			password = user.GetPassword()
+			err := userRepo.Create(ctx, user)
+			Expect(err).ToNot(HaveOccurred(), "failed to create user")
+		})
+
+		It("should store the password exactly as given", func() {
+			stored, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(stored.GetPassword()).To(Equal(password))
+		})
+
+		It("should retrieve the user by their id", func() {
+			stored, err := userRepo.GetById(ctx, user.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(stored.GetEmail()).To(Equal(user.GetEmail()))
+			Expect(stored.GetLastLoginAt()).To(BeNil(), "expected no login to be recorded on creation")
+		})
+
+		It("should record the time of the last login", func() {
+			loggedInAt := time.Now().Add(-time.Hour)
+			err := userRepo.UpdateLastLoginAt(ctx, user.GetId(), loggedInAt)
+			Expect(err).ToNot(HaveOccurred(), "failed to update last login")
+
+			stored, err := userRepo.GetById(ctx, user.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(stored.GetLastLoginAt().AsTime()).To(BeTemporally("~", loggedInAt, time.Millisecond))
+		})
+
+		It("should not hash a password that looks like an encoded hash", func() {
+			stored, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+
+			stored.Password = "$argon2id$v=19$m=65536,t=3,p=2$chosen$by-the-user"
+			err = userRepo.Update(ctx, stored)
+			Expect(err).ToNot(HaveOccurred(), "failed to update user")
+
+			updated, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve updated user")
+			Expect(updated.GetPassword()).To(Equal(stored.GetPassword()))
+		})
 	})
 
 	Context("When one user is updated", func() {
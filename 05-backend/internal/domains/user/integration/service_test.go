@@ -0,0 +1,313 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/user/application"
+	"shvdg/crazed-conquerer/internal/domains/user/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Service", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var recorder *testing.EventRecorder
+	var userRepo *infra.UserRepositoryImpl
+	var userService *application.UserService
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+		recorder = testing.NewEventRecorder()
+		userRepo = infra.NewUserRepositoryImpl(suite.Database)
+		userService = application.NewUserService(userRepo, passwords.Default(), recorder)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When a user logs in", func() {
+		var user *domain.UserEntity
+		var password string
+
+		BeforeAll(func() {
+			user = domain.NewUserEntity().WithDefaults().Build()
+			password = user.GetPassword()
+			user.Password, err = passwords.Default().Hash(password)
+			Expect(err).ToNot(HaveOccurred(), "failed to hash password")
+
+			err = userRepo.Create(ctx, user)
+			Expect(err).ToNot(HaveOccurred(), "failed to create user")
+		})
+
+		It("should raise a UserLoggedIn event for valid credentials", func() {
+			foundUser, err := userService.Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred(), "failed to authenticate user")
+			Expect(foundUser.GetId()).To(Equal(user.GetId()))
+
+			raised := recorder.OfType(domain.UserLoggedInEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 UserLoggedIn event")
+			Expect(raised[0].AggregateID()).To(Equal(user.GetId()))
+		})
+
+		It("should not raise a UserLoggedIn event for invalid credentials", func() {
+			_, err := userService.Login(ctx, user.GetEmail(), "wrong-password")
+			Expect(err).To(MatchError(domain.ErrInvalidCredentials))
+			Expect(recorder.OfType(domain.UserLoggedInEvent)).To(HaveLen(1))
+		})
+
+		It("should record the time of the login", func() {
+			loggedIn, err := userService.Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred(), "failed to log in")
+
+			after, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(after.GetLastLoginAt()).ToNot(BeNil())
+			Expect(after.GetLastLoginAt().AsTime()).To(BeTemporally("~", loggedIn.GetLastLoginAt().AsTime(), time.Millisecond))
+		})
+
+		It("should accept the email address in other case", func() {
+			_, err := userService.Login(ctx, "  "+strings.ToUpper(user.GetEmail()), password)
+			Expect(err).ToNot(HaveOccurred(), "failed to log in")
+		})
+
+		It("should return ErrInvalidCredentials for an unknown email", func() {
+			_, err := userService.Login(ctx, "unknown@example.com", password)
+			Expect(err).To(MatchError(domain.ErrInvalidCredentials))
+		})
+
+		It("should not accept the stored hash as the password", func() {
+			stored, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+
+			_, err = userService.Login(ctx, user.GetEmail(), stored.GetPassword())
+			Expect(err).To(MatchError(domain.ErrInvalidCredentials))
+		})
+	})
+
+	Context("When a user registers", func() {
+		var user *domain.UserEntity
+
+		BeforeAll(func() {
+			user, err = userService.Register(ctx, " New.Player@Example.com", "correct horse 42", " New Player ")
+			Expect(err).ToNot(HaveOccurred(), "failed to register user")
+		})
+
+		It("should store the user with normalized details and a hashed password", func() {
+			stored, err := userRepo.GetById(ctx, user.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(stored.GetEmail()).To(Equal("new.player@example.com"))
+			Expect(stored.GetDisplayName()).To(Equal("New Player"))
+			Expect(passwords.IsHashed(stored.GetPassword())).To(BeTrue(), "expected an encoded hash")
+		})
+
+		It("should let the user log in", func() {
+			_, err := userService.Login(ctx, "new.player@example.com", "correct horse 42")
+			Expect(err).ToNot(HaveOccurred(), "failed to log in")
+		})
+
+		It("should hash a password that looks like an encoded hash", func() {
+			looksHashed := "$argon2id$v=19$m=65536,t=3,p=2$chosen$by-the-user"
+			_, err := userService.Register(ctx, "hash.lookalike@example.com", looksHashed, "Lookalike")
+			Expect(err).ToNot(HaveOccurred(), "failed to register user")
+
+			_, err = userService.Login(ctx, "hash.lookalike@example.com", looksHashed)
+			Expect(err).ToNot(HaveOccurred(), "failed to log in")
+		})
+
+		It("should return ErrEmailTaken for a registered email", func() {
+			_, err := userService.Register(ctx, "NEW.PLAYER@example.com", "another horse 42", "Other Player")
+			Expect(err).To(MatchError(domain.ErrEmailTaken))
+		})
+
+		It("should return the error of the broken rule", func() {
+			_, err := userService.Register(ctx, "invalid", "correct horse 42", "Player")
+			Expect(err).To(MatchError(domain.ErrInvalidEmail))
+
+			_, err = userService.Register(ctx, "weak@example.com", "weak", "Player")
+			Expect(err).To(MatchError(domain.ErrWeakPassword))
+
+			_, err = userService.Register(ctx, "named@example.com", "correct horse 42", "<b>")
+			Expect(err).To(MatchError(domain.ErrInvalidDisplayName))
+		})
+	})
+
+	Context("When a user changes their password", func() {
+		var user *domain.UserEntity
+
+		BeforeAll(func() {
+			user, err = userService.Register(ctx, "changer@example.com", "first password 1", "Changer")
+			Expect(err).ToNot(HaveOccurred(), "failed to register user")
+		})
+
+		It("should refuse a wrong current password", func() {
+			err := userService.ChangePassword(ctx, user.GetId(), "wrong password 1", "second password 2")
+			Expect(err).To(MatchError(domain.ErrInvalidCredentials))
+		})
+
+		It("should refuse a weak new password", func() {
+			err := userService.ChangePassword(ctx, user.GetId(), "first password 1", "weak")
+			Expect(err).To(MatchError(domain.ErrWeakPassword))
+		})
+
+		It("should replace the password and raise a UserPasswordChanged event", func() {
+			err := userService.ChangePassword(ctx, user.GetId(), "first password 1", "second password 2")
+			Expect(err).ToNot(HaveOccurred(), "failed to change password")
+
+			_, err = userService.Login(ctx, user.GetEmail(), "first password 1")
+			Expect(err).To(MatchError(domain.ErrInvalidCredentials))
+			_, err = userService.Login(ctx, user.GetEmail(), "second password 2")
+			Expect(err).ToNot(HaveOccurred(), "failed to log in with the new password")
+
+			raised := recorder.OfType(domain.UserPasswordChangedEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 UserPasswordChanged event")
+			Expect(raised[0].AggregateID()).To(Equal(user.GetId()))
+		})
+	})
+
+	Context("When the password of a user is reset", func() {
+		var user *domain.UserEntity
+
+		BeforeAll(func() {
+			user, err = userService.Register(ctx, "forgetful@example.com", "forgotten password 1", "Forgetful")
+			Expect(err).ToNot(HaveOccurred(), "failed to register user")
+		})
+
+		It("should refuse a weak new password", func() {
+			_, err := userService.ResetPassword(ctx, user.GetEmail(), "weak")
+			Expect(err).To(MatchError(domain.ErrWeakPassword))
+		})
+
+		It("should return ErrNotFound for an unknown email", func() {
+			_, err := userService.ResetPassword(ctx, "nobody@example.com", "reset password 2")
+			Expect(err).To(MatchError(database.ErrNotFound))
+		})
+
+		It("should replace the password without the current one and raise a UserPasswordChanged event", func() {
+			reset, err := userService.ResetPassword(ctx, " Forgetful@Example.com", "reset password 2")
+			Expect(err).ToNot(HaveOccurred(), "failed to reset password")
+			Expect(reset.GetId()).To(Equal(user.GetId()))
+
+			_, err = userService.Login(ctx, user.GetEmail(), "forgotten password 1")
+			Expect(err).To(MatchError(domain.ErrInvalidCredentials))
+			_, err = userService.Login(ctx, user.GetEmail(), "reset password 2")
+			Expect(err).ToNot(HaveOccurred(), "failed to log in with the reset password")
+
+			var raised []string
+			for _, event := range recorder.OfType(domain.UserPasswordChangedEvent) {
+				raised = append(raised, event.AggregateID())
+			}
+			Expect(raised).To(ContainElement(user.GetId()), "expected a UserPasswordChanged event")
+		})
+	})
+
+	Context("When a user deletes their account", func() {
+		var user *domain.UserEntity
+
+		BeforeAll(func() {
+			user, err = userService.Register(ctx, "leaver@example.com", "leaving soon 1", "Leaver")
+			Expect(err).ToNot(HaveOccurred(), "failed to register user")
+		})
+
+		It("should refuse a wrong password", func() {
+			err := userService.DeleteAccount(ctx, user.GetId(), "wrong password 1")
+			Expect(err).To(MatchError(domain.ErrInvalidCredentials))
+		})
+
+		It("should delete the user and raise a UserDeleted event", func() {
+			err := userService.DeleteAccount(ctx, user.GetId(), "leaving soon 1")
+			Expect(err).ToNot(HaveOccurred(), "failed to delete account")
+
+			_, err = userRepo.GetById(ctx, user.GetId())
+			Expect(err).To(MatchError(database.ErrNotFound))
+
+			raised := recorder.OfType(domain.UserDeletedEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 UserDeleted event")
+			Expect(raised[0].AggregateID()).To(Equal(user.GetId()))
+		})
+
+		It("should return ErrNotFound once the account is deleted", func() {
+			err := userService.DeleteAccount(ctx, user.GetId(), "leaving soon 1")
+			Expect(err).To(MatchError(database.ErrNotFound))
+		})
+	})
+
+	Context("When the hashing parameters have changed", func() {
+		var user *domain.UserEntity
+		var password string
+		var stronger *passwords.Hasher
+
+		BeforeAll(func() {
+			user = domain.NewUserEntity().WithDefaults().Build()
+			password = user.GetPassword()
+			user.Password, err = passwords.Default().Hash(password)
+			Expect(err).ToNot(HaveOccurred(), "failed to hash password")
+
+			err = userRepo.Create(ctx, user)
+			Expect(err).ToNot(HaveOccurred(), "failed to create user")
+
+			stronger = passwords.NewHasher(passwords.WithArgon2id(passwords.DefaultMemory*2, passwords.DefaultIterations, passwords.DefaultParallelism))
+		})
+
+		It("should rehash the password on login", func() {
+			before, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(stronger.NeedsRehash(before.GetPassword())).To(BeTrue())
+
+			_, err = application.NewUserService(userRepo, stronger).Login(ctx, user.GetEmail(), password)
+			Expect(err).ToNot(HaveOccurred(), "failed to authenticate user")
+
+			after, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(after.GetPassword()).ToNot(Equal(before.GetPassword()))
+			Expect(stronger.NeedsRehash(after.GetPassword())).To(BeFalse())
+		})
+	})
+
+	Context("When passwords were stored as plaintext", func() {
+		var user *domain.UserEntity
+
+		BeforeAll(func() {
+			user = domain.NewUserEntity().WithDefaults().Build()
+			err := userRepo.Create(ctx, user)
+			Expect(err).ToNot(HaveOccurred(), "failed to insert plaintext user")
+		})
+
+		It("should hash them when migrating", func() {
+			err := database.Execute(ctx, suite.Database, infra.HashPlaintextPasswordsQuery)
+			Expect(err).ToNot(HaveOccurred(), "failed to hash plaintext passwords")
+
+			stored, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(stored.GetPassword()).ToNot(Equal(user.GetPassword()))
+			Expect(passwords.IsHashed(stored.GetPassword())).To(BeTrue(), "expected an encoded hash")
+		})
+
+		It("should upgrade them to argon2id on login", func() {
+			_, err := userService.Login(ctx, user.GetEmail(), user.GetPassword())
+			Expect(err).ToNot(HaveOccurred(), "failed to authenticate user")
+
+			stored, err := userRepo.GetByEmail(ctx, user.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to retrieve user")
+			Expect(passwords.Default().NeedsRehash(stored.GetPassword())).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/converters"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+	"time"
+)
+
+// ZoneEntityBuilder helps build and configure a ZoneEntity object.
+type ZoneEntityBuilder struct {
+	zoneEntity *ZoneEntity
+	faker      *faker.Faker
+}
+
+// NewZoneEntity initializes a new ZoneEntityBuilder with empty values.
+func NewZoneEntity() *ZoneEntityBuilder {
+	return &ZoneEntityBuilder{zoneEntity: &ZoneEntity{}, faker: faker.Default()}
+}
+
+// WithFaker sets the faker that generates the random values, so that they can be reproduced from its seed.
+func (b *ZoneEntityBuilder) WithFaker(faker *faker.Faker) *ZoneEntityBuilder {
+	b.faker = faker
+	return b
+}
+
+// WithId sets the id of the zone entity.
+func (b *ZoneEntityBuilder) WithId(id string) *ZoneEntityBuilder {
+	b.zoneEntity.Id = id
+	return b
+}
+
+// WithRandomId sets a random id for the zone entity.
+func (b *ZoneEntityBuilder) WithRandomId() *ZoneEntityBuilder {
+	b.zoneEntity.Id = b.faker.UUID()
+	return b
+}
+
+// WithRows sets the rows of the zone entity.
+func (b *ZoneEntityBuilder) WithRows(rows []*ZoneRowEntity) *ZoneEntityBuilder {
+	b.zoneEntity.Rows = rows
+	return b
+}
+
+// WithEmptyRows sets an empty rows array for the zone entity.
+func (b *ZoneEntityBuilder) WithEmptyRows() *ZoneEntityBuilder {
+	b.zoneEntity.Rows = []*ZoneRowEntity{}
+	return b
+}
+
+// WithRowsFromJson directly unmarshal ZoneRowEntity array from JSON
+func (b *ZoneEntityBuilder) WithRowsFromJson(rowsJson []byte) *ZoneEntityBuilder {
+	rows, err := fromRowsJsonToRowsEntity(rowsJson)
+	if err != nil {
+		b.WithEmptyRows()
+	} else {
+		b.WithRows(rows)
+	}
+
+	return b
+}
+
+// WithCreatedAt sets the creation time of the zone entity.
+func (b *ZoneEntityBuilder) WithCreatedAt(t time.Time) *ZoneEntityBuilder {
+	b.zoneEntity.CreatedAt = converters.TimeToTimestamp(t)
+	return b
+}
+
+// WithUpdatedAt sets the updated at time of the zone entity.
+func (b *ZoneEntityBuilder) WithUpdatedAt(t time.Time) *ZoneEntityBuilder {
+	b.zoneEntity.UpdatedAt = converters.TimeToTimestamp(t)
+	return b
+}
+
+// WithDefaults populates all fields with random default values.
+func (b *ZoneEntityBuilder) WithDefaults() *ZoneEntityBuilder {
+	now := time.Now()
+	return b.WithRandomId().
+		WithEmptyRows().
+		WithCreatedAt(now).
+		WithUpdatedAt(now)
+}
+
+// Build returns the configured ZoneEntity object.
+func (b *ZoneEntityBuilder) Build() *ZoneEntity {
+	return b.zoneEntity
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+// Errors returned when a generator is configured with values it cannot lay out a zone with
+var (
+	ErrInvalidTerritoryRadius = errors.New("territory radius must not be negative")
+	ErrInvalidTerritorySize   = errors.New("territory width and height must be positive")
+	ErrMissingFactions        = errors.New("at least one faction is required")
+)
@@ -1 +1,38 @@
 package domain
+
+import (
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/events"
+)
+
+// The types of the events raised by the zone domain
+const (
+	ZoneCreatedEvent = "zone.created"
+)
+
+// ZoneCreated is raised when a new zone has been stored
+type ZoneCreated struct {
+	events.Base
+	ZoneId string
+	Rows   int32
+}
+
+// NewZoneCreated creates the event raised when the zone has been stored
+func NewZoneCreated(zone *ZoneEntity) *ZoneCreated {
+	return &ZoneCreated{Base: events.NewBase(zone.GetId()), ZoneId: zone.GetId(), Rows: int32(len(zone.GetRows()))}
+}
+
+// Type implements events.Event
+func (e *ZoneCreated) Type() string { return ZoneCreatedEvent }
+
+// Data implements events.Event, returning the protobuf payload
+func (e *ZoneCreated) Data() any {
+	return &ZoneCreatedPayload{ZoneId: e.ZoneId, Rows: e.Rows}
+}
+
+// RegisterEvents registers the payloads of the events of the domain
+func RegisterEvents(registry *events.Registry) error {
+	return errors.Join(
+		registry.Register(ZoneCreatedEvent, 1, &ZoneCreatedPayload{}),
+	)
+}
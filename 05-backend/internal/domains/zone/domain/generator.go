@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+)
+
+// Defaults used by the Generator
+const (
+	DefaultTerritoryRadius int32 = 1
+	DefaultTerritoryWidth  int32 = 4
+	DefaultTerritoryHeight int32 = 4
+
+	// biomeVariationPercentage is the chance for a tile to differ from the biome of its territory
+	biomeVariationPercentage = 15
+	// villagePercentage is the chance for an undeveloped tile to contain a village
+	villagePercentage = 4
+	// ruinsPercentage is the chance for an undeveloped tile to contain ruins
+	ruinsPercentage = 2
+)
+
+// territory represents a chunk of the zone that belongs to a single faction
+type territory struct {
+	name    string
+	center  *sharedDomain.Coordinates
+	faction sharedDomain.Faction
+	biome   string
+	start   bool
+}
+
+// Generator produces zones from a seed, the same seed always results in the same rows
+type Generator struct {
+	seed            int64
+	territoryRadius int32
+	territoryWidth  int32
+	territoryHeight int32
+	startFaction    sharedDomain.Faction
+	factions        []sharedDomain.Faction
+}
+
+// GeneratorOpt configures the Generator during initialization
+type GeneratorOpt func(*Generator)
+
+// WithTerritoryRadius sets the number of territories between the starting territory and the edge of the zone
+func WithTerritoryRadius(radius int32) GeneratorOpt {
+	return func(g *Generator) {
+		g.territoryRadius = radius
+	}
+}
+
+// WithTerritorySize sets the number of columns and rows between the centers of neighbouring territories
+func WithTerritorySize(width, height int32) GeneratorOpt {
+	return func(g *Generator) {
+		g.territoryWidth = width
+		g.territoryHeight = height
+	}
+}
+
+// WithStartFaction sets the faction that owns the starting territory
+func WithStartFaction(faction sharedDomain.Faction) GeneratorOpt {
+	return func(g *Generator) {
+		g.startFaction = faction
+	}
+}
+
+// WithFactions sets the factions the remaining territories are divided among
+func WithFactions(factions ...sharedDomain.Faction) GeneratorOpt {
+	return func(g *Generator) {
+		g.factions = factions
+	}
+}
+
+// NewGenerator initializes a new Generator, the seed determines every random decision made during generation.
+// Options that leave the generator without territories or factions to lay out are refused.
+func NewGenerator(seed int64, options ...GeneratorOpt) (*Generator, error) {
+	generator := &Generator{
+		seed:            seed,
+		territoryRadius: DefaultTerritoryRadius,
+		territoryWidth:  DefaultTerritoryWidth,
+		territoryHeight: DefaultTerritoryHeight,
+		startFaction:    sharedDomain.Faction_FACTION_HUMAN,
+		factions:        []sharedDomain.Faction{sharedDomain.Faction_FACTION_HUMAN, sharedDomain.Faction_FACTION_ORC},
+	}
+
+	for _, option := range options {
+		option(generator)
+	}
+
+	if err := generator.validate(); err != nil {
+		return nil, fmt.Errorf("failed to configure generator: %w", err)
+	}
+
+	return generator, nil
+}
+
+// validate checks the generator can lay out at least the starting territory and divide the others among its factions
+func (g *Generator) validate() error {
+	var errs []error
+	if g.territoryRadius < 0 {
+		errs = append(errs, fmt.Errorf("%w: %d", ErrInvalidTerritoryRadius, g.territoryRadius))
+	}
+	if g.territoryWidth <= 0 || g.territoryHeight <= 0 {
+		errs = append(errs, fmt.Errorf("%w: %dx%d", ErrInvalidTerritorySize, g.territoryWidth, g.territoryHeight))
+	}
+	if len(g.factions) == 0 {
+		errs = append(errs, ErrMissingFactions)
+	}
+	return errors.Join(errs...)
+}
+
+// GetSeed returns the seed the generator was initialized with
+func (g *Generator) GetSeed() int64 {
+	return g.seed
+}
+
+// Generate produces a new zone with the given id, the starting tile is located at the coordinates 0,0
+func (g *Generator) Generate(id string) *ZoneEntity {
+	return NewZoneEntity().
+		WithId(id).
+		WithRows(g.GenerateRows()).
+		Build()
+}
+
+// GenerateRows produces the rows of a zone, ordered from top to bottom and from left to right
+func (g *Generator) GenerateRows() []*ZoneRowEntity {
+	random := rand.New(rand.NewSource(g.seed))
+	territories := g.generateTerritories(random)
+	start := sharedDomain.NewCoordinates(0, 0)
+
+	minX, maxX, minY, maxY := g.bounds()
+	rows := make([]*ZoneRowEntity, 0, maxY-minY+1)
+	for y := minY; y <= maxY; y++ {
+		columns := make([]*ZoneColumnEntity, 0, maxX-minX+1)
+		for x := minX; x <= maxX; x++ {
+			tile := sharedDomain.NewCoordinates(x, y)
+			owner := nearestTerritory(territories, tile)
+
+			biome := owner.biome
+			if random.Intn(100) < biomeVariationPercentage {
+				biome = Biomes[random.Intn(len(Biomes))]
+			}
+
+			// territory centers hold the starting tile and the fortifications, which must never be impassable
+			landscapes := LandscapesByBiome[biome]
+			if owner.center.X == tile.X && owner.center.Y == tile.Y {
+				landscapes = passableLandscapes(landscapes)
+			}
+
+			columns = append(columns, &ZoneColumnEntity{
+				PositionX: x,
+				PositionY: y,
+				Level:     max(start.DistanceTo(tile), 1),
+				Territory: owner.name,
+				Faction:   owner.faction.String(),
+				Biome:     biome,
+				Landscape: landscapes[random.Intn(len(landscapes))],
+				Structure: structureFor(random, owner, tile),
+			})
+		}
+		rows = append(rows, &ZoneRowEntity{Columns: columns})
+	}
+
+	return rows
+}
+
+// generateTerritories lays out the territories in columns, every odd column shifted half a territory down
+func (g *Generator) generateTerritories(random *rand.Rand) []*territory {
+	var territories []*territory
+	for chunkX := -g.territoryRadius; chunkX <= g.territoryRadius; chunkX++ {
+		for chunkY := -g.territoryRadius; chunkY <= g.territoryRadius; chunkY++ {
+			start := chunkX == 0 && chunkY == 0
+
+			faction := g.startFaction
+			if !start {
+				faction = g.factions[random.Intn(len(g.factions))]
+			}
+
+			territories = append(territories, &territory{
+				name:    fmt.Sprintf("%d,%d", chunkX, chunkY),
+				center:  g.territoryCenter(chunkX, chunkY),
+				faction: faction,
+				biome:   Biomes[random.Intn(len(Biomes))],
+				start:   start,
+			})
+		}
+	}
+	return territories
+}
+
+// territoryCenter returns the coordinates of the tile at the center of the territory
+func (g *Generator) territoryCenter(chunkX, chunkY int32) *sharedDomain.Coordinates {
+	shift := (chunkX & 1) * (g.territoryHeight / 2)
+	return sharedDomain.NewCoordinates(chunkX*g.territoryWidth, chunkY*g.territoryHeight+shift)
+}
+
+// bounds returns the outermost columns and rows of the zone
+func (g *Generator) bounds() (minX, maxX, minY, maxY int32) {
+	reachX := g.territoryRadius*g.territoryWidth + g.territoryWidth/2
+	reachY := g.territoryRadius*g.territoryHeight + g.territoryHeight/2
+	return -reachX, reachX, -reachY, reachY + g.territoryHeight/2
+}
+
+// structureFor returns the structure built on the tile, territory centers always contain a fortification
+func structureFor(random *rand.Rand, owner *territory, tile *sharedDomain.Coordinates) string {
+	roll := random.Intn(100)
+	isCenter := owner.center.X == tile.X && owner.center.Y == tile.Y
+
+	switch {
+	case isCenter && owner.start:
+		return StructureCastle
+	case isCenter:
+		return StructureStronghold
+	case roll < villagePercentage:
+		return StructureVillage
+	case roll < villagePercentage+ruinsPercentage:
+		return StructureRuins
+	default:
+		return StructureNone
+	}
+}
+
+// passableLandscapes returns the landscapes that can be entered
+func passableLandscapes(landscapes []string) []string {
+	passable := make([]string, 0, len(landscapes))
+	for _, landscape := range landscapes {
+		if movementCostOf(LandscapeMovementCosts, landscape) != Impassable {
+			passable = append(passable, landscape)
+		}
+	}
+	return passable
+}
+
+// nearestTerritory returns the territory whose center is closest to the tile, ties go to the first territory.
+// The territories always contain the starting territory, as the generator refuses a negative radius.
+func nearestTerritory(territories []*territory, tile *sharedDomain.Coordinates) *territory {
+	nearest := territories[0]
+	nearestDistance := nearest.center.DistanceTo(tile)
+	for _, candidate := range territories[1:] {
+		if distance := candidate.center.DistanceTo(tile); distance < nearestDistance {
+			nearest, nearestDistance = candidate, distance
+		}
+	}
+	return nearest
+}
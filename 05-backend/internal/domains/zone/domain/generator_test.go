@@ -0,0 +1,169 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+// newGenerator creates a generator with options that are known to be valid
+func newGenerator(seed int64, options ...GeneratorOpt) *Generator {
+	generator, err := NewGenerator(seed, options...)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return generator
+}
+
+// columnsOf flattens the rows into a single slice of columns
+func columnsOf(rows []*ZoneRowEntity) []*ZoneColumnEntity {
+	var columns []*ZoneColumnEntity
+	for _, row := range rows {
+		columns = append(columns, row.GetColumns()...)
+	}
+	return columns
+}
+
+// columnAt returns the column located at the given coordinates, if any
+func columnAt(rows []*ZoneRowEntity, x, y int32) *ZoneColumnEntity {
+	for _, column := range columnsOf(rows) {
+		if column.GetPositionX() == x && column.GetPositionY() == y {
+			return column
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Generator", func() {
+	Context("When generating with the same seed", func() {
+		It("should always produce the same rows", func() {
+			first := newGenerator(42).GenerateRows()
+			second := newGenerator(42).GenerateRows()
+
+			Expect(first).To(HaveLen(len(second)))
+			for i := range first {
+				Expect(proto.Equal(first[i], second[i])).To(BeTrue(), "expected row %d to be equal", i)
+			}
+		})
+
+		It("should produce different rows for a different seed", func() {
+			first := columnsOf(newGenerator(42).GenerateRows())
+			second := columnsOf(newGenerator(43).GenerateRows())
+
+			equal := len(first) == len(second)
+			for i := 0; equal && i < len(first); i++ {
+				equal = proto.Equal(first[i], second[i])
+			}
+			Expect(equal).To(BeFalse())
+		})
+	})
+
+	Context("When generating a zone", func() {
+		var rows []*ZoneRowEntity
+
+		BeforeEach(func() {
+			rows = newGenerator(7, WithStartFaction(sharedDomain.Faction_FACTION_ORC)).GenerateRows()
+		})
+
+		It("should place a castle of the start faction on the starting tile", func() {
+			start := columnAt(rows, 0, 0)
+			Expect(start).ToNot(BeNil())
+			Expect(start.GetLevel()).To(Equal(int32(1)))
+			Expect(start.GetTerritory()).To(Equal("0,0"))
+			Expect(start.GetFaction()).To(Equal(sharedDomain.Faction_FACTION_ORC.String()))
+			Expect(start.GetStructure()).To(Equal(StructureCastle))
+		})
+
+		It("should scale the level with the distance from the starting tile", func() {
+			start := sharedDomain.NewCoordinates(0, 0)
+			for _, column := range columnsOf(rows) {
+				distance := start.DistanceTo(sharedDomain.NewCoordinates(column.GetPositionX(), column.GetPositionY()))
+				Expect(column.GetLevel()).To(Equal(max(distance, 1)))
+			}
+		})
+
+		It("should fill every tile with a known biome and landscape", func() {
+			for _, column := range columnsOf(rows) {
+				Expect(Biomes).To(ContainElement(column.GetBiome()))
+				Expect(LandscapesByBiome[column.GetBiome()]).To(ContainElement(column.GetLandscape()))
+			}
+		})
+
+		It("should assign every territory to a single faction", func() {
+			factions := make(map[string]string)
+			for _, column := range columnsOf(rows) {
+				if faction, found := factions[column.GetTerritory()]; found {
+					Expect(column.GetFaction()).To(Equal(faction))
+				}
+				factions[column.GetTerritory()] = column.GetFaction()
+			}
+			Expect(factions).To(HaveLen(9))
+		})
+	})
+
+	Context("When generating zones from many seeds", func() {
+		It("should never place the starting tile or a fortification on impassable terrain", func() {
+			for seed := int64(0); seed < 200; seed++ {
+				rows := newGenerator(seed).GenerateRows()
+
+				start := columnAt(rows, 0, 0)
+				Expect(MovementCost(start)).ToNot(Equal(Impassable), "expected the starting tile of seed %d to be passable", seed)
+
+				for _, column := range columnsOf(rows) {
+					if column.GetStructure() == StructureCastle || column.GetStructure() == StructureStronghold {
+						Expect(MovementCost(column)).ToNot(Equal(Impassable), "expected the %s of seed %d to be passable", column.GetStructure(), seed)
+					}
+				}
+			}
+		})
+	})
+
+	Context("When generating a zone entity", func() {
+		It("should use the given id and the rows of the seed", func() {
+			zone := newGenerator(42).Generate("zone-id")
+
+			Expect(zone.GetId()).To(Equal("zone-id"))
+			Expect(zone.GetRows()).To(HaveLen(len(newGenerator(42).GenerateRows())))
+		})
+	})
+
+	Context("When the generator is configured with invalid values", func() {
+		It("should refuse a negative territory radius", func() {
+			_, err := NewGenerator(1, WithTerritoryRadius(-1))
+			Expect(err).To(MatchError(ErrInvalidTerritoryRadius))
+		})
+
+		It("should refuse territories without columns or rows", func() {
+			_, err := NewGenerator(1, WithTerritorySize(0, 4))
+			Expect(err).To(MatchError(ErrInvalidTerritorySize))
+
+			_, err = NewGenerator(1, WithTerritorySize(4, -2))
+			Expect(err).To(MatchError(ErrInvalidTerritorySize))
+		})
+
+		It("should refuse to divide territories among no factions", func() {
+			_, err := NewGenerator(1, WithFactions())
+			Expect(err).To(MatchError(ErrMissingFactions))
+		})
+
+		It("should generate a zone of only the starting territory without a radius", func() {
+			rows := newGenerator(1, WithTerritoryRadius(0)).GenerateRows()
+
+			for _, column := range columnsOf(rows) {
+				Expect(column.GetTerritory()).To(Equal("0,0"))
+			}
+		})
+	})
+
+	Context("When generating a larger zone", func() {
+		It("should create a territory for every chunk within the radius", func() {
+			rows := newGenerator(1, WithTerritoryRadius(2)).GenerateRows()
+
+			territories := make(map[string]bool)
+			for _, column := range columnsOf(rows) {
+				territories[column.GetTerritory()] = true
+			}
+			Expect(territories).To(HaveLen(25))
+		})
+	})
+})
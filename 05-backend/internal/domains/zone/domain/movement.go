@@ -0,0 +1,82 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+)
+
+// Impassable is the movement cost of a tile that cannot be entered
+const Impassable int32 = -1
+
+// BiomeMovementCosts contains the base cost of entering a tile of each biome
+var BiomeMovementCosts = map[string]int32{
+	BiomeGrassland: 1,
+	BiomeForest:    2,
+	BiomeDesert:    2,
+	BiomeSwamp:     3,
+	BiomeTundra:    2,
+}
+
+// LandscapeMovementCosts contains the additional cost of entering a tile of each landscape
+var LandscapeMovementCosts = map[string]int32{
+	LandscapePlains:    0,
+	LandscapeHills:     1,
+	LandscapeMountains: 3,
+	LandscapeWoods:     1,
+	LandscapeDunes:     1,
+	LandscapeMarsh:     1,
+	LandscapeLake:      Impassable,
+}
+
+// StructureMovementCosts contains the additional cost of entering a tile with each structure
+var StructureMovementCosts = map[string]int32{
+	StructureNone:       0,
+	StructureCastle:     0,
+	StructureStronghold: 0,
+	StructureVillage:    0,
+	StructureRuins:      1,
+}
+
+// MovementCost returns the cost of entering the tile, or Impassable when any of its features blocks movement
+func MovementCost(column *ZoneColumnEntity) int32 {
+	var total int32
+	for _, cost := range []int32{
+		movementCostOf(BiomeMovementCosts, column.GetBiome()),
+		movementCostOf(LandscapeMovementCosts, column.GetLandscape()),
+		movementCostOf(StructureMovementCosts, column.GetStructure()),
+	} {
+		if cost < 0 {
+			return Impassable
+		}
+		total += cost
+	}
+	return total
+}
+
+// NewZoneGraph creates a graph over the tiles of the zone, the occupied tiles and tiles outside the zone are blocked
+func NewZoneGraph(rows []*ZoneRowEntity, occupied ...*sharedDomain.Coordinates) *sharedDomain.HexGraph {
+	costs := make(map[[2]int32]int32)
+	for _, row := range rows {
+		for _, column := range row.GetColumns() {
+			costs[[2]int32{column.GetPositionX(), column.GetPositionY()}] = MovementCost(column)
+		}
+	}
+
+	for _, position := range occupied {
+		costs[[2]int32{position.X, position.Y}] = Impassable
+	}
+
+	return sharedDomain.NewHexGraph(func(position *sharedDomain.Coordinates) int32 {
+		if cost, found := costs[[2]int32{position.X, position.Y}]; found {
+			return cost
+		}
+		return Impassable
+	})
+}
+
+// movementCostOf returns the cost of the feature, unknown features are treated as impassable
+func movementCostOf(costs map[string]int32, feature string) int32 {
+	if cost, found := costs[feature]; found {
+		return cost
+	}
+	return Impassable
+}
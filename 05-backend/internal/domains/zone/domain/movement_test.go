@@ -0,0 +1,85 @@
+package domain
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newTestRows creates a zone of grassland plains with the given size
+func newTestRows(width, height int32) []*ZoneRowEntity {
+	rows := make([]*ZoneRowEntity, 0, height)
+	for y := int32(0); y < height; y++ {
+		row := &ZoneRowEntity{}
+		for x := int32(0); x < width; x++ {
+			row.Columns = append(row.Columns, &ZoneColumnEntity{
+				PositionX: x,
+				PositionY: y,
+				Biome:     BiomeGrassland,
+				Landscape: LandscapePlains,
+				Structure: StructureNone,
+			})
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+var _ = Describe("Movement", func() {
+	Context("When calculating the movement cost of a tile", func() {
+		It("should add up the costs of the biome, landscape and structure", func() {
+			column := &ZoneColumnEntity{Biome: BiomeForest, Landscape: LandscapeHills, Structure: StructureRuins}
+			Expect(MovementCost(column)).To(Equal(int32(4)))
+		})
+
+		It("should be impassable when one of the features blocks movement", func() {
+			column := &ZoneColumnEntity{Biome: BiomeGrassland, Landscape: LandscapeLake}
+			Expect(MovementCost(column)).To(Equal(Impassable))
+		})
+
+		It("should be impassable when a feature is unknown", func() {
+			column := &ZoneColumnEntity{Biome: "lava", Landscape: LandscapePlains}
+			Expect(MovementCost(column)).To(Equal(Impassable))
+		})
+	})
+
+	Context("When moving across the zone", func() {
+		var rows []*ZoneRowEntity
+
+		BeforeEach(func() {
+			rows = newTestRows(5, 5)
+		})
+
+		It("should avoid costly tiles when a cheaper route exists", func() {
+			rows[1].Columns[1].Landscape = LandscapeMountains
+			rows[2].Columns[1].Landscape = LandscapeMountains
+
+			path, cost, found := sharedDomain.FindPath(NewZoneGraph(rows), sharedDomain.NewCoordinates(0, 2), sharedDomain.NewCoordinates(2, 2), 20)
+
+			Expect(found).To(BeTrue())
+			Expect(cost).To(Equal(int32(4)))
+			Expect(path).To(HaveLen(5))
+			for _, tile := range path {
+				Expect(tile.X == 1 && (tile.Y == 1 || tile.Y == 2)).To(BeFalse(), "expected the path to avoid the mountains")
+			}
+		})
+
+		It("should not move onto occupied tiles or outside the zone", func() {
+			occupied := []*sharedDomain.Coordinates{sharedDomain.NewCoordinates(1, 0), sharedDomain.NewCoordinates(0, 1)}
+
+			reachable := sharedDomain.FindReachable(NewZoneGraph(rows, occupied...), sharedDomain.NewCoordinates(0, 0), 3)
+			Expect(reachable).To(BeEmpty())
+		})
+
+		It("should stay within the movement budget", func() {
+			start := sharedDomain.NewCoordinates(2, 2)
+			reachable := sharedDomain.FindReachable(NewZoneGraph(rows), start, 1)
+
+			Expect(reachable).To(HaveLen(6))
+			for _, tile := range reachable {
+				Expect(start.DistanceTo(tile)).To(Equal(int32(1)))
+			}
+		})
+	})
+})
@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+// ZoneRepository representation of a zone repository
+type ZoneRepository interface {
+	GetById(ctx context.Context, id string) (*ZoneEntity, error)
+}
@@ -0,0 +1,42 @@
+package domain
+
+// Biomes that can cover a tile
+const (
+	BiomeGrassland = "grassland"
+	BiomeForest    = "forest"
+	BiomeDesert    = "desert"
+	BiomeSwamp     = "swamp"
+	BiomeTundra    = "tundra"
+)
+
+// Landscapes that shape a tile
+const (
+	LandscapePlains    = "plains"
+	LandscapeHills     = "hills"
+	LandscapeMountains = "mountains"
+	LandscapeWoods     = "woods"
+	LandscapeDunes     = "dunes"
+	LandscapeMarsh     = "marsh"
+	LandscapeLake      = "lake"
+)
+
+// Structures that can be built on a tile, an empty structure means the tile is undeveloped
+const (
+	StructureNone       = ""
+	StructureCastle     = "castle"
+	StructureStronghold = "stronghold"
+	StructureVillage    = "village"
+	StructureRuins      = "ruins"
+)
+
+// Biomes contains every biome in a fixed order
+var Biomes = []string{BiomeGrassland, BiomeForest, BiomeDesert, BiomeSwamp, BiomeTundra}
+
+// LandscapesByBiome contains the landscapes that can occur within each biome
+var LandscapesByBiome = map[string][]string{
+	BiomeGrassland: {LandscapePlains, LandscapePlains, LandscapeHills, LandscapeLake},
+	BiomeForest:    {LandscapeWoods, LandscapeWoods, LandscapeHills, LandscapeLake},
+	BiomeDesert:    {LandscapeDunes, LandscapeDunes, LandscapePlains, LandscapeMountains},
+	BiomeSwamp:     {LandscapeMarsh, LandscapeMarsh, LandscapeWoods, LandscapeLake},
+	BiomeTundra:    {LandscapePlains, LandscapeHills, LandscapeMountains, LandscapeMountains},
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fromRowsJsonToRowsEntity converts a JSON array of zone rows to a slice of ZoneRowEntity's.
+func fromRowsJsonToRowsEntity(rowsJson []byte) ([]*ZoneRowEntity, error) {
+	var rowsRaw []json.RawMessage
+	if err := json.Unmarshal(rowsJson, &rowsRaw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal zone rows: %w", err)
+	}
+
+	rows := make([]*ZoneRowEntity, len(rowsRaw))
+	for i, rowJson := range rowsRaw {
+		row := &ZoneRowEntity{}
+		if err := protojson.Unmarshal(rowJson, row); err != nil {
+			return rows, fmt.Errorf("failed to unmarshal zone row: %w", err)
+		}
+		rows[i] = row
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,145 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/zone/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"shvdg/crazed-conquerer/internal/shared/sql"
+)
+
+// ZoneRepositoryImpl provides the concrete implementation of the ZoneRepository interface
+type ZoneRepositoryImpl struct {
+	database.Connection
+	recorder events.Recorder
+}
+
+// NewZoneRepositoryImpl creates a new instance of ZoneRepositoryImpl, the recorders receive the events it raises
+func NewZoneRepositoryImpl(connection database.Connection, recorders ...events.Recorder) *ZoneRepositoryImpl {
+	return &ZoneRepositoryImpl{Connection: connection, recorder: events.Recorders(recorders)}
+}
+
+// GetById retrieves a zone by its id
+func (s *ZoneRepositoryImpl) GetById(ctx context.Context, id string) (*domain.ZoneEntity, error) {
+	query, args := sql.NewQuery().
+		Select(FieldId, FieldRows, FieldCreatedAt, FieldUpdatedAt).
+		From(TableName).
+		Where(FieldId, id).
+		Build()
+
+	return s.ReadOne(ctx, query, args, ScanZoneEntity)
+}
+
+// Create implements Repository.Create
+func (s *ZoneRepositoryImpl) Create(ctx context.Context, entities ...*domain.ZoneEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		rows, err := json.Marshal(entity.GetRows())
+		if err != nil {
+			return fmt.Errorf("failed to marshal zone rows: %w", err)
+		}
+
+		argSets[i] = []any{entity.GetId(), json.RawMessage(rows)}
+	}
+
+	query, batchArgs := sql.NewQuery().
+		InsertInto(TableName).
+		InsertFields(FieldId, FieldRows).
+		BatchValues(argSets).
+		BuildBatch()
+
+	if err := database.Batch(ctx, s.Connection, query, batchArgs); err != nil {
+		return err
+	}
+
+	raised := make([]events.Event, len(entities))
+	for i, entity := range entities {
+		raised[i] = domain.NewZoneCreated(entity)
+	}
+	return s.recorder.Record(ctx, raised...)
+}
+
+// Update updates one or more zone entities in the database
+func (s *ZoneRepositoryImpl) Update(ctx context.Context, entities ...*domain.ZoneEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		rows, err := json.Marshal(entity.GetRows())
+		if err != nil {
+			return fmt.Errorf("failed to marshal zone rows: %w", err)
+		}
+
+		argSets[i] = []any{json.RawMessage(rows), entity.GetId()}
+	}
+
+	query, batchArgs := sql.NewQuery().
+		Update(TableName).
+		BatchSets(argSets, FieldRows).
+		Where(FieldId).
+		BuildBatch()
+
+	return database.Batch(ctx, s.Connection, query, batchArgs)
+}
+
+// Upsert upserts one or more zone entities in the database
+func (s *ZoneRepositoryImpl) Upsert(ctx context.Context, entities ...*domain.ZoneEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	argSets := make([][]any, len(entities))
+	for i, entity := range entities {
+		rows, err := json.Marshal(entity.GetRows())
+		if err != nil {
+			return fmt.Errorf("failed to marshal zone rows: %w", err)
+		}
+
+		argSets[i] = []any{entity.GetId(), json.RawMessage(rows)}
+	}
+
+	query, batchArgs := sql.NewQuery().
+		InsertInto(TableName).
+		InsertFields(FieldId, FieldRows).
+		BatchUpsert(argSets, []string{FieldId}, FieldRows).
+		BuildBatch()
+
+	return database.Batch(ctx, s.Connection, query, batchArgs)
+}
+
+// Delete removes one or more zone entities from the database
+func (s *ZoneRepositoryImpl) Delete(ctx context.Context, entities ...*domain.ZoneEntity) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	ids := make([]any, len(entities))
+	for i, entity := range entities {
+		ids[i] = entity.GetId()
+	}
+
+	query, args := sql.NewQuery().
+		DeleteFrom(TableName).
+		WhereIn(FieldId, ids...).
+		Build()
+
+	return database.Execute(ctx, s.Connection, query, args...)
+}
+
+// ReadOne executes a query and returns a single zone entity
+func (s *ZoneRepositoryImpl) ReadOne(ctx context.Context, query string, values []any, scan database.ScannerFunc[*domain.ZoneEntity]) (*domain.ZoneEntity, error) {
+	return database.QueryOne(ctx, s.Connection, query, values, scan)
+}
+
+// ReadMany executes a query and returns multiple zone entities
+func (s *ZoneRepositoryImpl) ReadMany(ctx context.Context, query string, values []any, scan database.ScannerFunc[*domain.ZoneEntity]) ([]*domain.ZoneEntity, error) {
+	return database.QueryMany(ctx, s.Connection, query, values, scan)
+}
@@ -0,0 +1,33 @@
+package infrastructure
+
+import (
+	"fmt"
+	"shvdg/crazed-conquerer/internal/domains/zone/domain"
+	"shvdg/crazed-conquerer/internal/shared/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ScanZoneEntity scans database row data into a ZoneEntity
+func ScanZoneEntity(scanner database.RowScanner) (*domain.ZoneEntity, error) {
+	var id string
+	var rowsJson []byte
+	var createdAt, updatedAt pgtype.Timestamp
+
+	if err := scanner.Scan(&id, &rowsJson, &createdAt, &updatedAt); err != nil {
+		return nil, fmt.Errorf("failed to scan zone entity: %w", err)
+	}
+
+	builder := domain.NewZoneEntity().
+		WithId(id).
+		WithRowsFromJson(rowsJson)
+
+	if createdAt.Valid {
+		builder = builder.WithCreatedAt(createdAt.Time)
+	}
+	if updatedAt.Valid {
+		builder = builder.WithUpdatedAt(updatedAt.Time)
+	}
+
+	return builder.Build(), nil
+}
@@ -0,0 +1,27 @@
+package integration
+
+// smallRowsJson is a small JSON sample for rows.
+var smallRowsJson = []byte(`[
+				{
+					"columns": [
+						{"position_x": 0, "position_y": 0, "level": 1, "territory": "territory_1", "faction": "FACTION_HUMAN", "biome": "grassland", "landscape": "plains", "structure": "castle"},
+						{"position_x": 1, "position_y": 0, "level": 2, "territory": "territory_1", "faction": "FACTION_HUMAN", "biome": "forest", "landscape": "hills", "structure": ""}
+					]
+				}
+			]`)
+
+// mediumRowsJson is a medium JSON sample for rows.
+var mediumRowsJson = []byte(`[
+				{
+					"columns": [
+						{"position_x": 0, "position_y": 0, "level": 1, "territory": "territory_1", "faction": "FACTION_HUMAN", "biome": "grassland", "landscape": "plains", "structure": "castle"},
+						{"position_x": 1, "position_y": 0, "level": 2, "territory": "territory_1", "faction": "FACTION_HUMAN", "biome": "forest", "landscape": "hills", "structure": ""}
+					]
+				},
+				{
+					"columns": [
+						{"position_x": 0, "position_y": 1, "level": 3, "territory": "territory_2", "faction": "FACTION_ORC", "biome": "desert", "landscape": "dunes", "structure": "camp"},
+						{"position_x": 1, "position_y": 1, "level": 4, "territory": "territory_2", "faction": "FACTION_ORC", "biome": "swamp", "landscape": "marsh", "structure": ""}
+					]
+				}
+			]`)
@@ -0,0 +1,222 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/domains/zone/domain"
+	infra "shvdg/crazed-conquerer/internal/domains/zone/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/sql"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Zone Repository", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var recorder *testing.EventRecorder
+	var zoneRepo *infra.ZoneRepositoryImpl
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+		recorder = testing.NewEventRecorder()
+		zoneRepo = infra.NewZoneRepositoryImpl(suite.Database, recorder)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When one zone is created", func() {
+		var zone *domain.ZoneEntity
+
+		BeforeAll(func() {
+			zone = domain.NewZoneEntity().WithDefaults().
+				WithRowsFromJson(smallRowsJson).
+				Build()
+		})
+
+		It("should successfully store the zone in the database", func() {
+			err := zoneRepo.Create(ctx, zone)
+			Expect(err).ToNot(HaveOccurred(), "failed to create zone")
+
+			query, args := sql.NewQuery().Count().From(infra.TableName).Where(infra.FieldId, zone.GetId()).Build()
+			count, err := database.QueryOne(ctx, suite.Database, query, args, database.ScanInt)
+
+			Expect(err).ToNot(HaveOccurred(), "failed to count zones")
+			Expect(count).To(Equal(1), "expected 1 zone to be created")
+		})
+
+		It("should raise a ZoneCreated event", func() {
+			raised := recorder.OfType(domain.ZoneCreatedEvent)
+			Expect(raised).To(HaveLen(1), "expected 1 ZoneCreated event")
+			Expect(raised[0].AggregateID()).To(Equal(zone.GetId()))
+		})
+	})
+
+	Context("When retrieving zone by ID", func() {
+		BeforeAll(func() {
+			zone := domain.NewZoneEntity().WithDefaults().
+				WithId("find-me-123").
+				WithRowsFromJson(smallRowsJson).
+				Build()
+			err := zoneRepo.Create(ctx, zone)
+			Expect(err).ToNot(HaveOccurred(), "failed to create zone")
+		})
+
+		It("should return the correct zone", func() {
+			foundZone, err := zoneRepo.GetById(ctx, "find-me-123")
+			Expect(err).ToNot(HaveOccurred(), "failed to get zone by ID")
+			Expect(foundZone).ToNot(BeNil(), "expected to find a zone")
+			Expect(foundZone.GetId()).To(Equal("find-me-123"))
+			Expect(foundZone.GetRows()).To(HaveLen(1), "expected 1 row")
+			Expect(foundZone.GetRows()[0].GetColumns()).To(HaveLen(2), "expected 2 columns")
+
+			firstColumn := foundZone.GetRows()[0].GetColumns()[0]
+			Expect(firstColumn.GetPositionX()).To(Equal(int32(0)))
+			Expect(firstColumn.GetPositionY()).To(Equal(int32(0)))
+			Expect(firstColumn.GetLevel()).To(Equal(int32(1)))
+			Expect(firstColumn.GetBiome()).To(Equal("grassland"))
+			Expect(firstColumn.GetStructure()).To(Equal("castle"))
+
+			secondColumn := foundZone.GetRows()[0].GetColumns()[1]
+			Expect(secondColumn.GetPositionX()).To(Equal(int32(1)))
+			Expect(secondColumn.GetPositionY()).To(Equal(int32(0)))
+			Expect(secondColumn.GetLevel()).To(Equal(int32(2)))
+			Expect(secondColumn.GetBiome()).To(Equal("forest"))
+			Expect(secondColumn.GetStructure()).To(BeEmpty())
+		})
+	})
+
+	Context("When one zone is updated", func() {
+		var originalZone, updatedZone *domain.ZoneEntity
+
+		BeforeAll(func() {
+			originalZone = domain.NewZoneEntity().WithDefaults().
+				WithId("update-test-123").
+				WithRowsFromJson(smallRowsJson).
+				Build()
+
+			err := zoneRepo.Create(ctx, originalZone)
+			Expect(err).ToNot(HaveOccurred(), "failed to create original zone")
+
+			updatedZone = domain.NewZoneEntity().WithDefaults().
+				WithId("update-test-123").
+				WithRowsFromJson(mediumRowsJson).
+				Build()
+		})
+
+		It("should successfully update the zone rows", func() {
+			err := zoneRepo.Update(ctx, updatedZone)
+			Expect(err).ToNot(HaveOccurred(), "failed to update zone")
+
+			foundZone, err := zoneRepo.GetById(ctx, "update-test-123")
+			Expect(err).ToNot(HaveOccurred(), "failed to get updated zone")
+			Expect(foundZone).ToNot(BeNil(), "expected to find the updated zone")
+
+			Expect(foundZone.GetRows()).To(HaveLen(2), "expected 2 row")
+			Expect(foundZone.GetRows()[0].GetColumns()).To(HaveLen(2), "expected 2 columns")
+
+			// Verify columns of the first row
+			firstRow := foundZone.GetRows()[0].GetColumns()
+			Expect(firstRow[0].GetPositionX()).To(Equal(int32(0)))
+			Expect(firstRow[0].GetPositionY()).To(Equal(int32(0)))
+			Expect(firstRow[0].GetLevel()).To(Equal(int32(1)))
+			Expect(firstRow[0].GetBiome()).To(Equal("grassland"))
+			Expect(firstRow[0].GetStructure()).To(Equal("castle"))
+
+			Expect(firstRow[1].GetPositionX()).To(Equal(int32(1)))
+			Expect(firstRow[1].GetPositionY()).To(Equal(int32(0)))
+			Expect(firstRow[1].GetLevel()).To(Equal(int32(2)))
+			Expect(firstRow[1].GetBiome()).To(Equal("forest"))
+			Expect(firstRow[1].GetStructure()).To(BeEmpty())
+
+			// Verify columns of the second row
+			secondRow := foundZone.GetRows()[1].GetColumns()
+			Expect(secondRow[0].GetPositionX()).To(Equal(int32(0)))
+			Expect(secondRow[0].GetPositionY()).To(Equal(int32(1)))
+			Expect(secondRow[0].GetLevel()).To(Equal(int32(3)))
+			Expect(secondRow[0].GetBiome()).To(Equal("desert"))
+			Expect(secondRow[0].GetStructure()).To(Equal("camp"))
+
+			Expect(secondRow[1].GetPositionX()).To(Equal(int32(1)))
+			Expect(secondRow[1].GetPositionY()).To(Equal(int32(1)))
+			Expect(secondRow[1].GetLevel()).To(Equal(int32(4)))
+			Expect(secondRow[1].GetBiome()).To(Equal("swamp"))
+			Expect(secondRow[1].GetStructure()).To(BeEmpty())
+		})
+	})
+
+	Context("When one zone is upserted", func() {
+		var upsertZone *domain.ZoneEntity
+
+		BeforeAll(func() {
+			upsertZone = domain.NewZoneEntity().WithDefaults().
+				WithId("upsert-test-123").
+				WithRowsFromJson(smallRowsJson).
+				Build()
+		})
+
+		It("should successfully upsert the zone", func() {
+			err := zoneRepo.Upsert(ctx, upsertZone)
+			Expect(err).ToNot(HaveOccurred(), "failed to upsert zone")
+
+			foundZone, err := zoneRepo.GetById(ctx, "upsert-test-123")
+			Expect(err).ToNot(HaveOccurred(), "failed to get zone by ID")
+			Expect(foundZone).ToNot(BeNil(), "expected to find a zone")
+			Expect(foundZone.GetId()).To(Equal("upsert-test-123"))
+			Expect(foundZone.GetRows()).To(HaveLen(1), "expected 1 row")
+			Expect(foundZone.GetRows()[0].GetColumns()).To(HaveLen(2), "expected 2 columns")
+
+			firstColumn := foundZone.GetRows()[0].GetColumns()[0]
+			Expect(firstColumn.GetPositionX()).To(Equal(int32(0)))
+			Expect(firstColumn.GetPositionY()).To(Equal(int32(0)))
+			Expect(firstColumn.GetLevel()).To(Equal(int32(1)))
+			Expect(firstColumn.GetBiome()).To(Equal("grassland"))
+			Expect(firstColumn.GetStructure()).To(Equal("castle"))
+
+			secondColumn := foundZone.GetRows()[0].GetColumns()[1]
+			Expect(secondColumn.GetPositionX()).To(Equal(int32(1)))
+			Expect(secondColumn.GetPositionY()).To(Equal(int32(0)))
+			Expect(secondColumn.GetLevel()).To(Equal(int32(2)))
+			Expect(secondColumn.GetBiome()).To(Equal("forest"))
+			Expect(secondColumn.GetStructure()).To(BeEmpty())
+		})
+	})
+
+	Context("When one zone is deleted", func() {
+		var zone *domain.ZoneEntity
+
+		BeforeAll(func() {
+			zone = domain.NewZoneEntity().WithDefaults().
+				WithRowsFromJson(smallRowsJson).
+				Build()
+
+			err := zoneRepo.Create(ctx, zone)
+			Expect(err).ToNot(HaveOccurred(), "failed to create zone")
+		})
+
+		It("should successfully remove the zone from the database", func() {
+			err := zoneRepo.Delete(ctx, zone)
+			Expect(err).ToNot(HaveOccurred(), "failed to delete zone")
+
+			query, args := sql.NewQuery().Count().From(infra.TableName).Where(infra.FieldId, zone.GetId()).Build()
+			count, err := database.QueryOne(ctx, suite.Database, query, args, database.ScanInt)
+
+			Expect(err).ToNot(HaveOccurred(), "failed to count zones")
+			Expect(count).To(BeZero(), "expected zone to be deleted")
+		})
+	})
+})
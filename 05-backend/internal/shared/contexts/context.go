@@ -8,6 +8,8 @@ import (
 
 type txKey struct{}
 
+type userIdKey struct{}
+
 // GetTransaction retrieves a transaction from context if it exists
 func GetTransaction(ctx context.Context) pgx.Tx {
 	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
@@ -20,3 +22,14 @@ func GetTransaction(ctx context.Context) pgx.Tx {
 func SetTransaction(ctx context.Context, tx pgx.Tx) context.Context {
 	return context.WithValue(ctx, txKey{}, tx)
 }
+
+// GetUserId retrieves the id of the authenticated user from context if it exists
+func GetUserId(ctx context.Context) (string, bool) {
+	userId, ok := ctx.Value(userIdKey{}).(string)
+	return userId, ok && userId != ""
+}
+
+// SetUserId adds the id of the authenticated user to the context
+func SetUserId(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, userIdKey{}, userId)
+}
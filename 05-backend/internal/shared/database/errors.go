@@ -0,0 +1,94 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE codes of the integrity constraint violations that are translated into typed errors
+const (
+	UniqueViolationCode     = "23505"
+	ForeignKeyViolationCode = "23503"
+	CheckViolationCode      = "23514"
+)
+
+// Errors returned by the database package, use errors.As with ConstraintError for the violated constraint
+var (
+	ErrNotFound       = errors.New("record not found")
+	ErrDuplicate      = errors.New("record already exists")
+	ErrForeignKey     = errors.New("referenced record does not exist or is still referenced")
+	ErrCheckViolation = errors.New("record violates a check constraint")
+)
+
+// ConstraintError is returned when a statement violates a constraint of the schema
+type ConstraintError struct {
+	Kind       error
+	Constraint string
+	Table      string
+	Detail     string
+
+	cause *pgconn.PgError
+}
+
+// Error implements error
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("%v: constraint '%s' on table '%s'", e.Kind, e.Constraint, e.Table)
+}
+
+// Is matches the sentinel error of the kind of violation
+func (e *ConstraintError) Is(target error) bool {
+	return target == e.Kind
+}
+
+// Unwrap returns the error reported by Postgres
+func (e *ConstraintError) Unwrap() error {
+	return e.cause
+}
+
+// TranslateError replaces missing rows and constraint violations by the typed errors of this package.
+// The original error stays in the chain, other errors are returned as they are.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		if errors.Is(err, ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	var kind error
+	switch pgErr.Code {
+	case UniqueViolationCode:
+		kind = ErrDuplicate
+	case ForeignKeyViolationCode:
+		kind = ErrForeignKey
+	case CheckViolationCode:
+		kind = ErrCheckViolation
+	default:
+		return err
+	}
+
+	var constraintErr *ConstraintError
+	if errors.As(err, &constraintErr) {
+		return err
+	}
+
+	return &ConstraintError{
+		Kind:       kind,
+		Constraint: pgErr.ConstraintName,
+		Table:      pgErr.TableName,
+		Detail:     pgErr.Detail,
+		cause:      pgErr,
+	}
+}
@@ -16,7 +16,7 @@ func Execute(ctx context.Context, connection Connection, script string, argument
 	return WithExecutor(ctx, connection, func(executor Executor) error {
 		_, err := executor.Exec(ctx, script, arguments...)
 		if err != nil {
-			return fmt.Errorf("failed to execute command: %w", err)
+			return fmt.Errorf("failed to execute command: %w", TranslateError(err))
 		}
 		return nil
 	})
@@ -32,7 +32,11 @@ func QueryOne[T any](ctx context.Context, connection Connection, query string, a
 
 	return WithExecutorResult(ctx, connection, func(executor Executor) (T, error) {
 		row := executor.QueryRow(ctx, query, args...)
-		return scan(row)
+		result, err := scan(row)
+		if err != nil {
+			return zero, TranslateError(err)
+		}
+		return result, nil
 	})
 }
 
@@ -46,7 +50,7 @@ func QueryMany[T any](ctx context.Context, connection Connection, query string,
 	return WithExecutorResult(ctx, connection, func(executor Executor) ([]T, error) {
 		rows, err := executor.Query(ctx, query, args...)
 		if err != nil {
-			return zero, fmt.Errorf("failed to execute command: %w", err)
+			return zero, fmt.Errorf("failed to execute command: %w", TranslateError(err))
 		}
 		defer rows.Close()
 
@@ -55,10 +59,13 @@ func QueryMany[T any](ctx context.Context, connection Connection, query string,
 		for rows.Next() {
 			result, err := scan(rows)
 			if err != nil {
-				return zero, fmt.Errorf("failed to scan row: %w", err)
+				return zero, fmt.Errorf("failed to scan row: %w", TranslateError(err))
 			}
 			results = append(results, result)
 		}
+		if err := rows.Err(); err != nil {
+			return zero, fmt.Errorf("failed to read rows: %w", TranslateError(err))
+		}
 
 		return results, nil
 	})
@@ -80,7 +87,7 @@ func Batch(ctx context.Context, connection Connection, query string, argSets [][
 		results := executor.SendBatch(ctx, batch)
 		err := results.Close()
 		if err != nil {
-			return fmt.Errorf("failed to execute batch: %w", err)
+			return fmt.Errorf("failed to execute batch: %w", TranslateError(err))
 		}
 
 		return nil
@@ -0,0 +1,120 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	createParentsQuery = `CREATE TABLE error_parents (
+		id INT PRIMARY KEY,
+		name TEXT NOT NULL,
+		CONSTRAINT uq_error_parents_name UNIQUE (name),
+		CONSTRAINT ck_error_parents_id CHECK (id > 0)
+	)`
+	createChildrenQuery = `CREATE TABLE error_children (
+		id INT PRIMARY KEY,
+		parent_id INT NOT NULL,
+		CONSTRAINT fk_error_children_parent FOREIGN KEY (parent_id) REFERENCES error_parents(id)
+	)`
+	insertParentQuery = "INSERT INTO error_parents (id, name) VALUES ($1, $2)"
+	insertChildQuery  = "INSERT INTO error_children (id, parent_id) VALUES ($1, $2)"
+	selectParentQuery = "SELECT name FROM error_parents WHERE id = $1"
+	deleteParentQuery = "DELETE FROM error_parents WHERE id = $1"
+)
+
+var _ = Describe("Errors", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+	var suite *testing.Suite
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+		Expect(database.Execute(ctx, suite.Database, createParentsQuery)).To(Succeed())
+		Expect(database.Execute(ctx, suite.Database, createChildrenQuery)).To(Succeed())
+		Expect(database.Execute(ctx, suite.Database, insertParentQuery, 1, "parent")).To(Succeed())
+		Expect(database.Execute(ctx, suite.Database, insertChildQuery, 1, 1)).To(Succeed())
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	// inSavepoint executes the statement within a savepoint, so the violation does not abort the transaction
+	inSavepoint := func(query string, args ...any) error {
+		return database.InTransaction(ctx, suite.Database, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+			return database.Execute(ctx, suite.Database, query, args...)
+		})
+	}
+
+	expectConstraintError := func(err error, kind error, constraint string) {
+		Expect(err).To(MatchError(kind))
+
+		var constraintErr *database.ConstraintError
+		Expect(errors.As(err, &constraintErr)).To(BeTrue(), "expected a ConstraintError")
+		Expect(constraintErr.Constraint).To(Equal(constraint))
+	}
+
+	When("a row is not found", func() {
+		It("should return ErrNotFound", func() {
+			_, err := database.QueryOne(ctx, suite.Database, selectParentQuery, []any{2}, database.ScanString)
+			Expect(err).To(MatchError(database.ErrNotFound))
+			Expect(err).To(MatchError(pgx.ErrNoRows), "expected the original error to remain")
+		})
+	})
+
+	When("a unique constraint is violated", func() {
+		It("should return ErrDuplicate with the constraint name", func() {
+			err := inSavepoint(insertParentQuery, 2, "parent")
+			expectConstraintError(err, database.ErrDuplicate, "uq_error_parents_name")
+		})
+
+		It("should return ErrDuplicate from a batch", func() {
+			err := database.InTransaction(ctx, suite.Database, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+				return database.Batch(ctx, suite.Database, insertParentQuery, [][]any{{3, "other"}, {1, "another"}})
+			})
+			expectConstraintError(err, database.ErrDuplicate, "error_parents_pkey")
+		})
+	})
+
+	When("a foreign key constraint is violated", func() {
+		It("should return ErrForeignKey when inserting", func() {
+			err := inSavepoint(insertChildQuery, 2, 99)
+			expectConstraintError(err, database.ErrForeignKey, "fk_error_children_parent")
+		})
+
+		It("should return ErrForeignKey when deleting", func() {
+			err := inSavepoint(deleteParentQuery, 1)
+			expectConstraintError(err, database.ErrForeignKey, "fk_error_children_parent")
+		})
+	})
+
+	When("a check constraint is violated", func() {
+		It("should return ErrCheckViolation", func() {
+			err := inSavepoint(insertParentQuery, -1, "negative")
+			expectConstraintError(err, database.ErrCheckViolation, "ck_error_parents_id")
+		})
+	})
+
+	When("the error is no violation", func() {
+		It("should return the error as it is", func() {
+			err := errors.New("other")
+			Expect(database.TranslateError(err)).To(BeIdenticalTo(err))
+			Expect(database.TranslateError(nil)).To(BeNil())
+		})
+	})
+})
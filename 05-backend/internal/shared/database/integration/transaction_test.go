@@ -0,0 +1,183 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	createItemsQuery = "CREATE TABLE IF NOT EXISTS transaction_items (name TEXT PRIMARY KEY)"
+	dropItemsQuery   = "DROP TABLE IF EXISTS transaction_items"
+	clearItemsQuery  = "DELETE FROM transaction_items"
+	insertItemQuery  = "INSERT INTO transaction_items (name) VALUES ($1)"
+	countItemsQuery  = "SELECT COUNT(*) FROM transaction_items"
+)
+
+var _ = Describe("InTransaction", Ordered, func() {
+	var ctx context.Context
+	var suite *testing.Suite
+	var options database.TransactionOptions
+
+	errFailed := errors.New("failed")
+	serializationFailure := &pgconn.PgError{Code: database.SerializationFailureCode}
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		ctx = suite.Context
+
+		err := database.Execute(ctx, suite.Database, createItemsQuery)
+		Expect(err).ToNot(HaveOccurred(), "failed to create table")
+	})
+
+	AfterAll(func() {
+		err := database.Execute(ctx, suite.Database, dropItemsQuery)
+		Expect(err).ToNot(HaveOccurred(), "failed to drop table")
+	})
+
+	BeforeEach(func() {
+		options = database.DefaultTransactionOptions()
+		options.InitialBackoff = time.Millisecond
+
+		err := database.Execute(ctx, suite.Database, clearItemsQuery)
+		Expect(err).ToNot(HaveOccurred(), "failed to clear table")
+	})
+
+	countItems := func() int {
+		count, err := database.QueryOne(ctx, suite.Database, countItemsQuery, nil, database.ScanInt)
+		Expect(err).ToNot(HaveOccurred(), "failed to count items")
+		return count
+	}
+
+	insertItem := func(ctx context.Context, name string) error {
+		return database.Execute(ctx, suite.Database, insertItemQuery, name)
+	}
+
+	When("the function succeeds", func() {
+		It("should commit the transaction", func() {
+			err := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				Expect(contexts.GetTransaction(ctx)).ToNot(BeNil())
+				return insertItem(ctx, "first")
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countItems()).To(Equal(1))
+		})
+	})
+
+	When("the function fails", func() {
+		It("should rollback the transaction and return the error", func() {
+			err := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				Expect(insertItem(ctx, "first")).To(Succeed())
+				return errFailed
+			})
+			Expect(err).To(MatchError(errFailed))
+			Expect(countItems()).To(Equal(0))
+		})
+	})
+
+	When("the function panics", func() {
+		It("should rollback the transaction and panic again", func() {
+			Expect(func() {
+				_ = database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+					Expect(insertItem(ctx, "first")).To(Succeed())
+					panic("boom")
+				})
+			}).To(PanicWith("boom"))
+			Expect(countItems()).To(Equal(0))
+		})
+	})
+
+	When("the call is nested", func() {
+		It("should only rollback the savepoint of the failing call", func() {
+			err := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				if err := insertItem(ctx, "outer"); err != nil {
+					return err
+				}
+
+				inner := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+					Expect(insertItem(ctx, "inner")).To(Succeed())
+					return errFailed
+				})
+				Expect(inner).To(MatchError(errFailed))
+
+				return database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+					return insertItem(ctx, "sibling")
+				})
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(countItems()).To(Equal(2))
+		})
+
+		It("should not retry a serialization failure within the savepoint", func() {
+			calls := 0
+			err := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				return database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+					calls++
+					if calls == 1 {
+						return serializationFailure
+					}
+					return nil
+				})
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal(2), "the outermost transaction is retried instead")
+		})
+	})
+
+	When("the function fails with a serialization failure", func() {
+		It("should retry the transaction", func() {
+			calls := 0
+			err := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				calls++
+				if err := insertItem(ctx, "first"); err != nil {
+					return err
+				}
+				if calls < 3 {
+					return serializationFailure
+				}
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal(3))
+			Expect(countItems()).To(Equal(1))
+		})
+
+		It("should give up once the retries are exhausted", func() {
+			calls := 0
+			options.MaxRetries = 2
+			err := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				calls++
+				return &pgconn.PgError{Code: database.DeadlockDetectedCode}
+			})
+			Expect(database.IsRetryable(err)).To(BeTrue())
+			Expect(calls).To(Equal(3))
+		})
+	})
+
+	When("the function fails with any other error", func() {
+		It("should not retry the transaction", func() {
+			calls := 0
+			err := database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				calls++
+				return insertItem(ctx, "")
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = database.InTransaction(ctx, suite.Database, options, func(ctx context.Context) error {
+				calls++
+				return insertItem(ctx, "")
+			})
+			Expect(database.IsRetryable(err)).To(BeFalse())
+			Expect(err).To(HaveOccurred(), "the name is already taken")
+			Expect(calls).To(Equal(2))
+		})
+	})
+})
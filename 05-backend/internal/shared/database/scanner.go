@@ -16,3 +16,21 @@ func ScanInt(scanner RowScanner) (int, error) {
 	}
 	return count, nil
 }
+
+// ScanString scans a single string value from a database row
+func ScanString(scanner RowScanner) (string, error) {
+	var value string
+	if err := scanner.Scan(&value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// ScanBool scans a single boolean value from a database row
+func ScanBool(scanner RowScanner) (bool, error) {
+	var value bool
+	if err := scanner.Scan(&value); err != nil {
+		return false, err
+	}
+	return value, nil
+}
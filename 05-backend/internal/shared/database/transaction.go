@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE codes of failures that are resolved by running the transaction again
+const (
+	SerializationFailureCode = "40001"
+	DeadlockDetectedCode     = "40P01"
+)
+
+// TransactionOptions configures how InTransaction begins and retries a transaction
+type TransactionOptions struct {
+	IsoLevel   pgx.TxIsoLevel
+	AccessMode pgx.TxAccessMode
+
+	// MaxRetries is the number of times a transaction is run again after a serialization failure or deadlock
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, it doubles with every retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultTransactionOptions returns the options used when none are provided
+func DefaultTransactionOptions() TransactionOptions {
+	return TransactionOptions{
+		MaxRetries:     3,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+}
+
+// InTransaction runs the function within a transaction that is stored in the context it receives, so that
+// repositories called by the function take part in it. The transaction commits when the function succeeds and
+// rolls back when it fails or panics. When the context already holds a transaction, the function runs within
+// a savepoint of it instead, and a failure only rolls back to that savepoint. Serialization failures and
+// deadlocks of an outermost transaction are retried with backoff.
+func InTransaction(ctx context.Context, connection Connection, options TransactionOptions, function func(ctx context.Context) error) error {
+	if outer := contexts.GetTransaction(ctx); outer != nil {
+		return runSavepoint(ctx, outer, function)
+	}
+
+	backoff := options.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		err := runTransaction(ctx, connection, options, function)
+		if err == nil || !IsRetryable(err) || attempt >= options.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to retry transaction: %w", errors.Join(err, ctx.Err()))
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, max(options.MaxBackoff, options.InitialBackoff))
+	}
+}
+
+// IsRetryable returns whether the error is a serialization failure or deadlock, which a retry could resolve
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == SerializationFailureCode || pgErr.Code == DeadlockDetectedCode
+}
+
+// runTransaction begins a transaction on the pool and runs the function within it
+func runTransaction(ctx context.Context, connection Connection, options TransactionOptions, function func(ctx context.Context) error) error {
+	transaction, err := connection.GetPool().BeginTx(ctx, pgx.TxOptions{IsoLevel: options.IsoLevel, AccessMode: options.AccessMode})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return finish(ctx, transaction, function)
+}
+
+// runSavepoint creates a savepoint within the transaction and runs the function within it
+func runSavepoint(ctx context.Context, outer pgx.Tx, function func(ctx context.Context) error) error {
+	savepoint, err := outer.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+	return finish(ctx, savepoint, function)
+}
+
+// finish runs the function with the transaction in its context, then commits or rolls back the transaction
+func finish(ctx context.Context, transaction pgx.Tx, function func(ctx context.Context) error) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			_ = transaction.Rollback(ctx)
+			panic(recovered)
+		}
+	}()
+
+	if err := function(contexts.SetTransaction(ctx, transaction)); err != nil {
+		if rollbackErr := transaction.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return errors.Join(err, fmt.Errorf("failed to rollback transaction: %w", rollbackErr))
+		}
+		return err
+	}
+
+	if err := transaction.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", TranslateError(err))
+	}
+	return nil
+}
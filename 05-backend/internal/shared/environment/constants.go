@@ -8,5 +8,8 @@ const (
 	KeyDbDsn      = "DB_DSN"
 	KeyDbDriver   = "DB_DRIVER"
 
-	KeyApiPort = "API_PORT"
+	KeyApiPort   = "API_PORT"
+	KeyJwtSecret = "JWT_SECRET"
+
+	KeyFakerSeed = "FAKER_SEED"
 )
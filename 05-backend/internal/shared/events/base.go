@@ -0,0 +1,24 @@
+package events
+
+import "time"
+
+// Base contains the aggregate and moment every domain event carries, domain events embed it
+type Base struct {
+	aggregateId string
+	occurredAt  time.Time
+}
+
+// NewBase creates the base of an event raised now for the aggregate
+func NewBase(aggregateId string) Base {
+	return Base{aggregateId: aggregateId, occurredAt: time.Now().UTC()}
+}
+
+// AggregateID implements Event.AggregateID
+func (b Base) AggregateID() string {
+	return b.aggregateId
+}
+
+// Timestamp implements Event.Timestamp
+func (b Base) Timestamp() time.Time {
+	return b.occurredAt
+}
@@ -0,0 +1,188 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// AllEvents is the event type that subscribes a handler to every published event
+const AllEvents = "*"
+
+// Errors returned by the InMemoryEventBus
+var (
+	ErrBusClosed      = errors.New("event bus is closed")
+	ErrQueueFull      = errors.New("event queue is full")
+	ErrNilHandler     = errors.New("event handler is nil")
+	ErrEmptyEventType = errors.New("event type is empty")
+	ErrHandlerPanic   = errors.New("event handler panicked")
+)
+
+// InMemoryEventBus delivers events to the handlers subscribed to their type within the same process.
+// By default events are delivered synchronously, WithAsyncDelivery hands them to a pool of workers instead.
+type InMemoryEventBus struct {
+	mutex    sync.RWMutex
+	handlers map[string][]EventHandler
+	closed   bool
+
+	workers   int
+	queueSize int
+	queue     chan Event
+	waitGroup sync.WaitGroup
+	onError   func(Event, error)
+}
+
+// InMemoryEventBusOpt configures the InMemoryEventBus during initialization
+type InMemoryEventBusOpt func(*InMemoryEventBus)
+
+// WithAsyncDelivery delivers events on the given number of workers, publishing fails once the queue is full
+func WithAsyncDelivery(workers, queueSize int) InMemoryEventBusOpt {
+	return func(b *InMemoryEventBus) {
+		b.workers = max(workers, 1)
+		b.queueSize = max(queueSize, 0)
+	}
+}
+
+// WithErrorHandler sets the function that receives the errors of asynchronously delivered events
+func WithErrorHandler(onError func(Event, error)) InMemoryEventBusOpt {
+	return func(b *InMemoryEventBus) {
+		b.onError = onError
+	}
+}
+
+// NewInMemoryEventBus creates a new instance of InMemoryEventBus
+func NewInMemoryEventBus(options ...InMemoryEventBusOpt) *InMemoryEventBus {
+	bus := &InMemoryEventBus{
+		handlers: make(map[string][]EventHandler),
+		onError: func(event Event, err error) {
+			log.Printf("failed to deliver event '%s': %v", event.Type(), err)
+		},
+	}
+
+	for _, option := range options {
+		option(bus)
+	}
+
+	if bus.workers > 0 {
+		bus.queue = make(chan Event, bus.queueSize)
+		for range bus.workers {
+			bus.waitGroup.Add(1)
+			go bus.work()
+		}
+	}
+
+	return bus
+}
+
+// Subscribe implements EventBus.Subscribe, handlers are called in the order they subscribed
+func (b *InMemoryEventBus) Subscribe(eventType string, handler EventHandler) error {
+	if eventType == "" {
+		return ErrEmptyEventType
+	}
+	if handler == nil {
+		return ErrNilHandler
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return ErrBusClosed
+	}
+
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+	return nil
+}
+
+// Publish implements EventBus.Publish. Synchronous delivery returns the joined errors of every handler,
+// asynchronous delivery only returns an error when the event could not be queued.
+func (b *InMemoryEventBus) Publish(event Event) error {
+	b.mutex.RLock()
+	if b.closed {
+		b.mutex.RUnlock()
+		return ErrBusClosed
+	}
+
+	if b.queue == nil {
+		b.mutex.RUnlock()
+		return b.dispatch(event)
+	}
+	defer b.mutex.RUnlock()
+
+	select {
+	case b.queue <- event:
+		return nil
+	default:
+		return fmt.Errorf("failed to publish event '%s': %w", event.Type(), ErrQueueFull)
+	}
+}
+
+// Shutdown stops accepting events and waits until the queued events have been delivered or the context is done
+func (b *InMemoryEventBus) Shutdown(ctx context.Context) error {
+	b.mutex.Lock()
+	if !b.closed {
+		b.closed = true
+		if b.queue != nil {
+			close(b.queue)
+		}
+	}
+	b.mutex.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		b.waitGroup.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to drain event bus: %w", ctx.Err())
+	}
+}
+
+// work delivers queued events until the queue is closed and empty
+func (b *InMemoryEventBus) work() {
+	defer b.waitGroup.Done()
+
+	for event := range b.queue {
+		if err := b.dispatch(event); err != nil {
+			b.onError(event, err)
+		}
+	}
+}
+
+// dispatch calls every handler subscribed to the type of the event, followed by the handlers subscribed to all events
+func (b *InMemoryEventBus) dispatch(event Event) error {
+	b.mutex.RLock()
+	handlers := make([]EventHandler, 0, len(b.handlers[event.Type()])+len(b.handlers[AllEvents]))
+	handlers = append(handlers, b.handlers[event.Type()]...)
+	handlers = append(handlers, b.handlers[AllEvents]...)
+	b.mutex.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handle(handler, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to handle event '%s': %w", event.Type(), err)
+	}
+	return nil
+}
+
+// handle calls the handler, turning a panic into an error so the remaining handlers still receive the event
+func handle(handler EventHandler, event Event) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v", ErrHandlerPanic, recovered)
+		}
+	}()
+
+	return handler(event)
+}
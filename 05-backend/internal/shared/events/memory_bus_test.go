@@ -0,0 +1,156 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// testEvent is a minimal Event used to exercise the bus
+type testEvent struct {
+	eventType string
+	id        string
+}
+
+func (e testEvent) Type() string         { return e.eventType }
+func (e testEvent) AggregateID() string  { return e.id }
+func (e testEvent) Timestamp() time.Time { return time.Time{} }
+func (e testEvent) Data() any            { return nil }
+
+var _ = Describe("InMemoryEventBus", func() {
+	Context("When events are delivered synchronously", func() {
+		var bus *InMemoryEventBus
+
+		BeforeEach(func() {
+			bus = NewInMemoryEventBus()
+		})
+
+		It("should only route events to the handlers of their type", func() {
+			var received []string
+			Expect(bus.Subscribe("created", func(event Event) error {
+				received = append(received, "created:"+event.AggregateID())
+				return nil
+			})).To(Succeed())
+			Expect(bus.Subscribe("deleted", func(event Event) error {
+				received = append(received, "deleted:"+event.AggregateID())
+				return nil
+			})).To(Succeed())
+			Expect(bus.Subscribe(AllEvents, func(event Event) error {
+				received = append(received, "all:"+event.AggregateID())
+				return nil
+			})).To(Succeed())
+
+			Expect(bus.Publish(testEvent{eventType: "created", id: "1"})).To(Succeed())
+			Expect(received).To(Equal([]string{"created:1", "all:1"}))
+		})
+
+		It("should reject invalid subscriptions", func() {
+			Expect(bus.Subscribe("", func(Event) error { return nil })).To(MatchError(ErrEmptyEventType))
+			Expect(bus.Subscribe("created", nil)).To(MatchError(ErrNilHandler))
+		})
+
+		It("should aggregate the errors of every handler", func() {
+			first, second := errors.New("first"), errors.New("second")
+			called := 0
+			Expect(bus.Subscribe("created", func(Event) error { called++; return first })).To(Succeed())
+			Expect(bus.Subscribe("created", func(Event) error { called++; return second })).To(Succeed())
+
+			err := bus.Publish(testEvent{eventType: "created"})
+			Expect(err).To(MatchError(first))
+			Expect(err).To(MatchError(second))
+			Expect(called).To(Equal(2))
+		})
+
+		It("should recover from panicking handlers", func() {
+			called := false
+			Expect(bus.Subscribe("created", func(Event) error { panic("boom") })).To(Succeed())
+			Expect(bus.Subscribe("created", func(Event) error { called = true; return nil })).To(Succeed())
+
+			err := bus.Publish(testEvent{eventType: "created"})
+			Expect(err).To(MatchError(ErrHandlerPanic))
+			Expect(err.Error()).To(ContainSubstring("boom"))
+			Expect(called).To(BeTrue())
+		})
+
+		It("should reject events once shut down", func() {
+			Expect(bus.Shutdown(context.Background())).To(Succeed())
+			Expect(bus.Publish(testEvent{eventType: "created"})).To(MatchError(ErrBusClosed))
+			Expect(bus.Subscribe("created", func(Event) error { return nil })).To(MatchError(ErrBusClosed))
+		})
+	})
+
+	Context("When events are delivered asynchronously", func() {
+		It("should deliver every queued event before the shutdown completes", func() {
+			var delivered atomic.Int32
+			bus := NewInMemoryEventBus(WithAsyncDelivery(4, 100))
+			Expect(bus.Subscribe("created", func(Event) error {
+				delivered.Add(1)
+				return nil
+			})).To(Succeed())
+
+			for range 100 {
+				Expect(bus.Publish(testEvent{eventType: "created"})).To(Succeed())
+			}
+
+			Expect(bus.Shutdown(context.Background())).To(Succeed())
+			Expect(delivered.Load()).To(Equal(int32(100)))
+		})
+
+		It("should report handler errors to the error handler", func() {
+			var mutex sync.Mutex
+			var reported []error
+			bus := NewInMemoryEventBus(WithAsyncDelivery(1, 1), WithErrorHandler(func(_ Event, err error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				reported = append(reported, err)
+			}))
+			Expect(bus.Subscribe("created", func(Event) error { return errors.New("failed") })).To(Succeed())
+
+			Expect(bus.Publish(testEvent{eventType: "created"})).To(Succeed())
+			Expect(bus.Shutdown(context.Background())).To(Succeed())
+			Expect(reported).To(HaveLen(1))
+		})
+
+		It("should refuse events when the queue is full", func() {
+			release := make(chan struct{})
+			bus := NewInMemoryEventBus(WithAsyncDelivery(1, 1))
+			Expect(bus.Subscribe("created", func(Event) error {
+				<-release
+				return nil
+			})).To(Succeed())
+
+			var err error
+			for range 3 {
+				if err = bus.Publish(testEvent{eventType: "created"}); err != nil {
+					break
+				}
+			}
+			Expect(err).To(MatchError(ErrQueueFull))
+
+			close(release)
+			Expect(bus.Shutdown(context.Background())).To(Succeed())
+		})
+
+		It("should stop waiting for the drain when the context is done", func() {
+			release := make(chan struct{})
+			bus := NewInMemoryEventBus(WithAsyncDelivery(1, 1))
+			Expect(bus.Subscribe("created", func(Event) error {
+				<-release
+				return nil
+			})).To(Succeed())
+			Expect(bus.Publish(testEvent{eventType: "created"})).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			Expect(bus.Shutdown(ctx)).To(MatchError(context.DeadlineExceeded))
+
+			close(release)
+			Expect(bus.Shutdown(context.Background())).To(Succeed())
+		})
+	})
+})
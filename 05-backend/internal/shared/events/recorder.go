@@ -0,0 +1,48 @@
+package events
+
+import (
+	"context"
+	"fmt"
+)
+
+// Recorder records the events raised while aggregates are changed, for example in a transactional outbox
+type Recorder interface {
+	Record(ctx context.Context, events ...Event) error
+}
+
+// Recorders records events with every recorder in order, no recorders means the events are dropped
+type Recorders []Recorder
+
+// Record implements Recorder.Record
+func (r Recorders) Record(ctx context.Context, raised ...Event) error {
+	if len(raised) == 0 {
+		return nil
+	}
+
+	for _, recorder := range r {
+		if err := recorder.Record(ctx, raised...); err != nil {
+			return fmt.Errorf("failed to record events: %w", err)
+		}
+	}
+	return nil
+}
+
+// BusRecorder publishes recorded events on the bus straight away, without waiting for a transaction to commit
+type BusRecorder struct {
+	bus EventBus
+}
+
+// NewBusRecorder creates a new instance of BusRecorder
+func NewBusRecorder(bus EventBus) *BusRecorder {
+	return &BusRecorder{bus: bus}
+}
+
+// Record implements Recorder.Record
+func (r *BusRecorder) Record(_ context.Context, raised ...Event) error {
+	for _, event := range raised {
+		if err := r.bus.Publish(event); err != nil {
+			return fmt.Errorf("failed to publish event '%s': %w", event.Type(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recorder", func() {
+	Context("When events are recorded on the bus", func() {
+		It("should publish every event in order", func() {
+			bus := NewInMemoryEventBus()
+			var received []string
+			Expect(bus.Subscribe(AllEvents, func(event Event) error {
+				received = append(received, event.AggregateID())
+				return nil
+			})).To(Succeed())
+
+			recorder := Recorders{NewBusRecorder(bus)}
+			Expect(recorder.Record(context.Background(), testEvent{eventType: "a", id: "1"}, testEvent{eventType: "b", id: "2"})).To(Succeed())
+			Expect(received).To(Equal([]string{"1", "2"}))
+		})
+
+		It("should return the error of a failing handler", func() {
+			bus := NewInMemoryEventBus()
+			Expect(bus.Subscribe("a", func(Event) error { return errors.New("failed") })).To(Succeed())
+
+			err := Recorders{NewBusRecorder(bus)}.Record(context.Background(), testEvent{eventType: "a"})
+			Expect(err).To(MatchError(ContainSubstring("failed")))
+		})
+	})
+
+	Context("When there are no recorders", func() {
+		It("should drop the events", func() {
+			Expect(Recorders(nil).Record(context.Background(), testEvent{eventType: "a"})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,226 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Errors returned by the Registry
+var (
+	ErrUnknownEventType    = errors.New("unknown event type")
+	ErrUnknownEventVersion = errors.New("unknown event version")
+	ErrDuplicateEvent      = errors.New("event version is already registered")
+	ErrMissingUpgrade      = errors.New("no upgrade between event versions")
+	ErrInvalidEventData    = errors.New("event data does not match its registered message")
+)
+
+// UpgradeFunc converts the payload of an event from one version to the next
+type UpgradeFunc func(proto.Message) (proto.Message, error)
+
+// eventSchema contains the registered versions of a single event type
+type eventSchema struct {
+	latest   int32
+	versions map[int32]proto.Message
+	upgrades map[int32]UpgradeFunc
+}
+
+// Registry maps event types to the protobuf messages of their payloads, so that events can be serialized.
+// Events are always written with the latest version of their type, older versions are upgraded when read.
+// Every type is registered during startup, before the registry is used to encode or decode events.
+type Registry struct {
+	mutex   sync.RWMutex
+	schemas map[string]*eventSchema
+}
+
+// NewRegistry creates a new instance of Registry
+func NewRegistry() *Registry {
+	return &Registry{schemas: make(map[string]*eventSchema)}
+}
+
+// Register adds the message as the payload of the version of the event type
+func (r *Registry) Register(eventType string, version int32, prototype proto.Message) error {
+	if eventType == "" {
+		return ErrEmptyEventType
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	schema, found := r.schemas[eventType]
+	if !found {
+		schema = &eventSchema{versions: make(map[int32]proto.Message), upgrades: make(map[int32]UpgradeFunc)}
+		r.schemas[eventType] = schema
+	}
+	if _, found := schema.versions[version]; found {
+		return fmt.Errorf("%w: '%s' version %d", ErrDuplicateEvent, eventType, version)
+	}
+
+	schema.versions[version] = prototype
+	schema.latest = max(schema.latest, version)
+	return nil
+}
+
+// RegisterUpgrade adds the function that converts the payload of the event type from the version to the next
+func (r *Registry) RegisterUpgrade(eventType string, fromVersion int32, upgrade UpgradeFunc) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	schema, found := r.schemas[eventType]
+	if !found {
+		return fmt.Errorf("%w: '%s'", ErrUnknownEventType, eventType)
+	}
+
+	schema.upgrades[fromVersion] = upgrade
+	return nil
+}
+
+// Wrap puts the event in an envelope, its data must be the message registered for the latest version of its type
+func (r *Registry) Wrap(event Event) (*EventEnvelope, error) {
+	schema, err := r.schemaOf(event.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := event.Data().(proto.Message)
+	if !ok || data.ProtoReflect().Descriptor().FullName() != schema.versions[schema.latest].ProtoReflect().Descriptor().FullName() {
+		return nil, fmt.Errorf("%w: '%s' version %d", ErrInvalidEventData, event.Type(), schema.latest)
+	}
+
+	payload, err := anypb.New(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap payload of event '%s': %w", event.Type(), err)
+	}
+
+	return &EventEnvelope{
+		Type:        event.Type(),
+		Version:     schema.latest,
+		AggregateId: event.AggregateID(),
+		OccurredAt:  timestamppb.New(event.Timestamp()),
+		Payload:     payload,
+	}, nil
+}
+
+// Unwrap takes the event out of the envelope, upgrading its payload to the latest version of its type
+func (r *Registry) Unwrap(envelope *EventEnvelope) (*DecodedEvent, error) {
+	schema, err := r.schemaOf(envelope.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	prototype, found := schema.versions[envelope.GetVersion()]
+	if !found {
+		return nil, fmt.Errorf("%w: '%s' version %d", ErrUnknownEventVersion, envelope.GetType(), envelope.GetVersion())
+	}
+
+	data := prototype.ProtoReflect().New().Interface()
+	if err := envelope.GetPayload().UnmarshalTo(data); err != nil {
+		return nil, fmt.Errorf("%w: '%s' version %d: %v", ErrInvalidEventData, envelope.GetType(), envelope.GetVersion(), err)
+	}
+
+	for version := envelope.GetVersion(); version < schema.latest; version++ {
+		upgrade, found := schema.upgrades[version]
+		if !found {
+			return nil, fmt.Errorf("%w: '%s' version %d", ErrMissingUpgrade, envelope.GetType(), version)
+		}
+		if data, err = upgrade(data); err != nil {
+			return nil, fmt.Errorf("failed to upgrade event '%s' from version %d: %w", envelope.GetType(), version, err)
+		}
+	}
+
+	return &DecodedEvent{
+		eventType:   envelope.GetType(),
+		version:     schema.latest,
+		aggregateId: envelope.GetAggregateId(),
+		occurredAt:  envelope.GetOccurredAt().AsTime(),
+		data:        data,
+	}, nil
+}
+
+// EncodeBinary serializes the event to the protobuf wire format
+func (r *Registry) EncodeBinary(event Event) ([]byte, error) {
+	envelope, err := r.Wrap(event)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(envelope)
+}
+
+// DecodeBinary deserializes an event encoded with EncodeBinary
+func (r *Registry) DecodeBinary(data []byte) (*DecodedEvent, error) {
+	envelope := &EventEnvelope{}
+	if err := proto.Unmarshal(data, envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event envelope: %w", err)
+	}
+	return r.Unwrap(envelope)
+}
+
+// EncodeJSON serializes the event to JSON
+func (r *Registry) EncodeJSON(event Event) ([]byte, error) {
+	envelope, err := r.Wrap(event)
+	if err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(envelope)
+}
+
+// DecodeJSON deserializes an event encoded with EncodeJSON
+func (r *Registry) DecodeJSON(data []byte) (*DecodedEvent, error) {
+	envelope := &EventEnvelope{}
+	if err := protojson.Unmarshal(data, envelope); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event envelope: %w", err)
+	}
+	return r.Unwrap(envelope)
+}
+
+// schemaOf returns the registered versions of the event type
+func (r *Registry) schemaOf(eventType string) (*eventSchema, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	schema, found := r.schemas[eventType]
+	if !found {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnknownEventType, eventType)
+	}
+	return schema, nil
+}
+
+// DecodedEvent is an event read back by the Registry, its data is the message of the latest version of its type
+type DecodedEvent struct {
+	eventType   string
+	version     int32
+	aggregateId string
+	occurredAt  time.Time
+	data        proto.Message
+}
+
+// Type implements Event.Type
+func (e *DecodedEvent) Type() string {
+	return e.eventType
+}
+
+// AggregateID implements Event.AggregateID
+func (e *DecodedEvent) AggregateID() string {
+	return e.aggregateId
+}
+
+// Timestamp implements Event.Timestamp
+func (e *DecodedEvent) Timestamp() time.Time {
+	return e.occurredAt
+}
+
+// Data implements Event.Data, returning the protobuf message of the payload
+func (e *DecodedEvent) Data() any {
+	return e.data
+}
+
+// Version returns the schema version of the payload
+func (e *DecodedEvent) Version() int32 {
+	return e.version
+}
@@ -0,0 +1,115 @@
+package events
+
+import (
+	sharedDomain "shvdg/crazed-conquerer/internal/shared/types"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// payloadEvent is an event carrying a protobuf payload
+type payloadEvent struct {
+	Base
+	eventType string
+	payload   any
+}
+
+func (e payloadEvent) Type() string { return e.eventType }
+func (e payloadEvent) Data() any    { return e.payload }
+
+var _ = Describe("Registry", func() {
+	var registry *Registry
+
+	BeforeEach(func() {
+		registry = NewRegistry()
+		Expect(registry.Register("unit.moved", 1, &sharedDomain.Coordinates{})).To(Succeed())
+	})
+
+	Context("When a registered event is encoded", func() {
+		event := payloadEvent{Base: NewBase("unit-1"), eventType: "unit.moved", payload: &sharedDomain.Coordinates{X: 3, Y: 4}}
+
+		It("should decode the same event from binary", func() {
+			data, err := registry.EncodeBinary(event)
+			Expect(err).ToNot(HaveOccurred(), "failed to encode event")
+
+			decoded, err := registry.DecodeBinary(data)
+			Expect(err).ToNot(HaveOccurred(), "failed to decode event")
+			Expect(decoded.Type()).To(Equal("unit.moved"))
+			Expect(decoded.Version()).To(Equal(int32(1)))
+			Expect(decoded.AggregateID()).To(Equal("unit-1"))
+			Expect(decoded.Timestamp()).To(BeTemporally("~", event.Timestamp(), time.Microsecond))
+			Expect(proto.Equal(decoded.Data().(proto.Message), &sharedDomain.Coordinates{X: 3, Y: 4})).To(BeTrue())
+		})
+
+		It("should decode the same event from JSON", func() {
+			data, err := registry.EncodeJSON(event)
+			Expect(err).ToNot(HaveOccurred(), "failed to encode event")
+			Expect(string(data)).To(ContainSubstring(`"aggregateId":"unit-1"`))
+
+			decoded, err := registry.DecodeJSON(data)
+			Expect(err).ToNot(HaveOccurred(), "failed to decode event")
+			Expect(proto.Equal(decoded.Data().(proto.Message), &sharedDomain.Coordinates{X: 3, Y: 4})).To(BeTrue())
+		})
+	})
+
+	Context("When an event cannot be encoded", func() {
+		It("should reject unknown event types", func() {
+			_, err := registry.EncodeJSON(payloadEvent{eventType: "unit.unknown", payload: &sharedDomain.Coordinates{}})
+			Expect(err).To(MatchError(ErrUnknownEventType))
+		})
+
+		It("should reject data that is not the registered message", func() {
+			_, err := registry.EncodeJSON(payloadEvent{eventType: "unit.moved", payload: wrapperspb.String("north")})
+			Expect(err).To(MatchError(ErrInvalidEventData))
+
+			_, err = registry.EncodeJSON(payloadEvent{eventType: "unit.moved", payload: "north"})
+			Expect(err).To(MatchError(ErrInvalidEventData))
+		})
+
+		It("should reject registering a version twice", func() {
+			Expect(registry.Register("unit.moved", 1, &sharedDomain.Coordinates{})).To(MatchError(ErrDuplicateEvent))
+		})
+	})
+
+	Context("When an event was encoded with an older version", func() {
+		var data []byte
+
+		BeforeEach(func() {
+			registry = NewRegistry()
+			Expect(registry.Register("unit.moved", 1, &wrapperspb.Int32Value{})).To(Succeed())
+
+			var err error
+			data, err = registry.EncodeBinary(payloadEvent{eventType: "unit.moved", payload: wrapperspb.Int32(7)})
+			Expect(err).ToNot(HaveOccurred(), "failed to encode event")
+
+			Expect(registry.Register("unit.moved", 2, &sharedDomain.Coordinates{})).To(Succeed())
+		})
+
+		It("should upgrade the payload to the latest version", func() {
+			Expect(registry.RegisterUpgrade("unit.moved", 1, func(message proto.Message) (proto.Message, error) {
+				return &sharedDomain.Coordinates{X: message.(*wrapperspb.Int32Value).GetValue()}, nil
+			})).To(Succeed())
+
+			decoded, err := registry.DecodeBinary(data)
+			Expect(err).ToNot(HaveOccurred(), "failed to decode event")
+			Expect(decoded.Version()).To(Equal(int32(2)))
+			Expect(decoded.Data().(*sharedDomain.Coordinates).GetX()).To(Equal(int32(7)))
+		})
+
+		It("should refuse to decode it without an upgrade", func() {
+			_, err := registry.DecodeBinary(data)
+			Expect(err).To(MatchError(ErrMissingUpgrade))
+		})
+
+		It("should refuse to decode versions that are not registered", func() {
+			unknown := NewRegistry()
+			Expect(unknown.Register("unit.moved", 2, &sharedDomain.Coordinates{})).To(Succeed())
+
+			_, err := unknown.DecodeBinary(data)
+			Expect(err).To(MatchError(ErrUnknownEventVersion))
+		})
+	})
+})
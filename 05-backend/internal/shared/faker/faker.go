@@ -0,0 +1,89 @@
+package faker
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"shvdg/crazed-conquerer/internal/shared/environment"
+	"strconv"
+	"sync"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+var (
+	defaultFaker *Faker
+	defaultOnce  sync.Once
+)
+
+// Faker generates fake data from a seed, Fakers with the same seed produce the same values in the same order.
+// Counters are scoped to the Faker, so numbered values restart for every Faker.
+type Faker struct {
+	*gofakeit.Faker
+	seed int64
+
+	mutex    sync.Mutex
+	counters map[string]uint64
+}
+
+// New creates a new instance of Faker that generates the values determined by the seed
+func New(seed int64) *Faker {
+	return &Faker{
+		Faker:    gofakeit.New(seed),
+		seed:     seed,
+		counters: make(map[string]uint64),
+	}
+}
+
+// NewRandom creates a new instance of Faker with a random seed, GetSeed returns it to reproduce the values
+func NewRandom() *Faker {
+	return New(RandomSeed())
+}
+
+// RandomSeed returns a seed that differs on every call, it is never zero as gofakeit treats zero as unseeded
+func RandomSeed() int64 {
+	return max(rand.Int63(), 1)
+}
+
+// ParseSeed parses a seed given as text, such as a flag or environment value
+func ParseSeed(value string) (int64, error) {
+	seed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seed == 0 {
+		return 0, fmt.Errorf("invalid seed '%s', expected a non-zero integer", value)
+	}
+	return seed, nil
+}
+
+// Default returns the Faker used by builders that were not given one.
+// It is seeded by the FAKER_SEED environment variable when set, and randomly otherwise. A seeded Faker repeats
+// its values on every run, so it is meant for tests and seed tooling only: production code must never take the
+// ids or other identities of stored entities from it, nor from builders that generate random values.
+func Default() *Faker {
+	defaultOnce.Do(func() {
+		seed := RandomSeed()
+		if value := environment.EnvStr(environment.KeyFakerSeed); value != "" {
+			parsed, err := ParseSeed(value)
+			if err != nil {
+				log.Printf("ignoring %s: %v", environment.KeyFakerSeed, err)
+			} else {
+				seed = parsed
+			}
+		}
+		defaultFaker = New(seed)
+	})
+	return defaultFaker
+}
+
+// GetSeed returns the seed the Faker was created with
+func (f *Faker) GetSeed() int64 {
+	return f.seed
+}
+
+// Next returns the next number of the named counter, starting at one
+func (f *Faker) Next(counter string) uint64 {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.counters[counter]++
+	return f.counters[counter]
+}
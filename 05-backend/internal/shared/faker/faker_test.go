@@ -0,0 +1,76 @@
+package faker_test
+
+import (
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Faker", func() {
+	When("two fakers share a seed", func() {
+		It("should generate the same values", func() {
+			first, second := faker.New(42), faker.New(42)
+			Expect(first.UUID()).To(Equal(second.UUID()))
+			Expect(first.Name()).To(Equal(second.Name()))
+			Expect(first.Number(1, 1000)).To(Equal(second.Number(1, 1000)))
+		})
+
+		It("should let builders generate the same entities", func() {
+			first, second := faker.New(42), faker.New(42)
+			for range 3 {
+				user := userDomain.NewUserEntity().WithFaker(first).WithDefaults().Build()
+				again := userDomain.NewUserEntity().WithFaker(second).WithDefaults().Build()
+				Expect(again.GetId()).To(Equal(user.GetId()))
+				Expect(again.GetEmail()).To(Equal(user.GetEmail()))
+				Expect(again.GetPassword()).To(Equal(user.GetPassword()))
+				Expect(again.GetDisplayName()).To(Equal(user.GetDisplayName()))
+
+				unit := unitDomain.NewUnitEntity().WithFaker(first).WithDefaults().Build()
+				unitAgain := unitDomain.NewUnitEntity().WithFaker(second).WithDefaults().Build()
+				Expect(unitAgain.GetId()).To(Equal(unit.GetId()))
+				Expect(unitAgain.GetVocation()).To(Equal(unit.GetVocation()))
+				Expect(unitAgain.GetName()).To(Equal(unit.GetName()))
+				Expect(unitAgain.GetLevel()).To(Equal(unit.GetLevel()))
+			}
+		})
+	})
+
+	When("two fakers have different seeds", func() {
+		It("should generate different values", func() {
+			Expect(faker.New(1).UUID()).ToNot(Equal(faker.New(2).UUID()))
+		})
+	})
+
+	When("counting", func() {
+		It("should count every counter separately, starting at one", func() {
+			f := faker.New(42)
+			Expect(f.Next("user")).To(Equal(uint64(1)))
+			Expect(f.Next("user")).To(Equal(uint64(2)))
+			Expect(f.Next("unit")).To(Equal(uint64(1)))
+			Expect(faker.New(42).Next("user")).To(Equal(uint64(1)), "expected counters to be scoped to the faker")
+		})
+	})
+
+	When("parsing a seed", func() {
+		It("should accept non-zero integers", func() {
+			seed, err := faker.ParseSeed("1234")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(seed).To(Equal(int64(1234)))
+		})
+
+		It("should reject zero and text", func() {
+			_, err := faker.ParseSeed("0")
+			Expect(err).To(HaveOccurred())
+			_, err = faker.ParseSeed("seed")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should keep the seed it was created with", func() {
+		Expect(faker.New(7).GetSeed()).To(Equal(int64(7)))
+		Expect(faker.NewRandom().GetSeed()).ToNot(BeZero())
+	})
+})
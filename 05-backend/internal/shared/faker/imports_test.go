@@ -0,0 +1,65 @@
+package faker_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakerPackage is the import path of the faker package
+const fakerPackage = "shvdg/crazed-conquerer/internal/shared/faker"
+
+// productionSources are the sources that create the identities of stored entities, which must never come from test data
+var productionSources = []string{
+	"../../domains/*/application/*.go",
+	"../../../apps/server/*/*.go",
+	"../../../apps/server/internal/*/*.go",
+	"../../../apps/server/internal/handlers/*/*.go",
+}
+
+var _ = Describe("Production code", func() {
+	It("should not generate values with the faker", func() {
+		files := productionFiles()
+		Expect(files).ToNot(BeEmpty(), "expected to find application sources")
+
+		for _, file := range files {
+			parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+			Expect(err).ToNot(HaveOccurred(), "failed to parse %s", file)
+
+			for _, spec := range parsed.Imports {
+				path, _ := strconv.Unquote(spec.Path.Value)
+				Expect(path).ToNot(Equal(fakerPackage), "%s imports the faker", file)
+			}
+
+			ast.Inspect(parsed, func(node ast.Node) bool {
+				if selector, ok := node.(*ast.SelectorExpr); ok {
+					name := selector.Sel.Name
+					Expect(name == "WithDefaults" || name == "WithFaker" || strings.HasPrefix(name, "WithRandom")).
+						To(BeFalse(), "%s generates values with the builder method %s", file, name)
+				}
+				return true
+			})
+		}
+	})
+})
+
+// productionFiles returns the non-test Go files of the production sources
+func productionFiles() []string {
+	var files []string
+	for _, pattern := range productionSources {
+		matches, err := filepath.Glob(pattern)
+		Expect(err).ToNot(HaveOccurred())
+		for _, match := range matches {
+			if !strings.HasSuffix(match, "_test.go") {
+				files = append(files, match)
+			}
+		}
+	}
+	return files
+}
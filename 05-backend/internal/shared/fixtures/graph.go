@@ -0,0 +1,235 @@
+package fixtures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	characterformationDomain "shvdg/crazed-conquerer/internal/domains/character-formation/domain"
+	characterformationinfra "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
+	characterunitDomain "shvdg/crazed-conquerer/internal/domains/character-unit/domain"
+	characterunitinfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
+	characterDomain "shvdg/crazed-conquerer/internal/domains/character/domain"
+	characterinfra "shvdg/crazed-conquerer/internal/domains/character/infrastructure"
+	formationDomain "shvdg/crazed-conquerer/internal/domains/formation/domain"
+	formationinfra "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
+	unitDomain "shvdg/crazed-conquerer/internal/domains/unit/domain"
+	unitinfra "shvdg/crazed-conquerer/internal/domains/unit/infrastructure"
+	usercharacterDomain "shvdg/crazed-conquerer/internal/domains/user-character/domain"
+	usercharacterinfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
+	userDomain "shvdg/crazed-conquerer/internal/domains/user/domain"
+	userinfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Defaults of the GraphBuilder
+const (
+	DefaultCharacters        = 1
+	DefaultUnitsPerCharacter = 5
+)
+
+// ErrInvalidGraphSize is returned when a number of entities within a graph is negative
+var ErrInvalidGraphSize = errors.New("number of entities within a graph must not be negative")
+
+// Graph is a user together with everything it owns
+type Graph struct {
+	User       *userDomain.UserEntity
+	Characters []*CharacterGraph
+}
+
+// CharacterGraph is a character together with its units and a formation placing those units
+type CharacterGraph struct {
+	Character *characterDomain.CharacterEntity
+	Units     []*unitDomain.UnitEntity
+	Formation *formationDomain.FormationEntity
+}
+
+// GraphBuilder helps build and insert a user with characters, units and formations.
+type GraphBuilder struct {
+	faker             *faker.Faker
+	user              *userDomain.UserEntity
+	characters        int
+	unitsPerCharacter int
+}
+
+// NewGraph initializes a new GraphBuilder with one character owning five units.
+func NewGraph() *GraphBuilder {
+	return &GraphBuilder{
+		faker:             faker.Default(),
+		characters:        DefaultCharacters,
+		unitsPerCharacter: DefaultUnitsPerCharacter,
+	}
+}
+
+// WithFaker sets the faker that generates the entities, so that they can be reproduced from its seed.
+func (b *GraphBuilder) WithFaker(faker *faker.Faker) *GraphBuilder {
+	b.faker = faker
+	return b
+}
+
+// WithUser sets the user the graph is built for, a random user is generated without it.
+func (b *GraphBuilder) WithUser(user *userDomain.UserEntity) *GraphBuilder {
+	b.user = user
+	return b
+}
+
+// WithCharacters sets the number of characters the user owns.
+func (b *GraphBuilder) WithCharacters(count int) *GraphBuilder {
+	b.characters = count
+	return b
+}
+
+// WithUnitsPerCharacter sets the number of units every character owns.
+func (b *GraphBuilder) WithUnitsPerCharacter(count int) *GraphBuilder {
+	b.unitsPerCharacter = count
+	return b
+}
+
+// Build returns the configured Graph without storing it.
+func (b *GraphBuilder) Build() (*Graph, error) {
+	if b.characters < 0 || b.unitsPerCharacter < 0 {
+		return nil, ErrInvalidGraphSize
+	}
+
+	user := b.user
+	if user == nil {
+		user = userDomain.NewUserEntity().WithFaker(b.faker).WithDefaults().Build()
+	}
+
+	graph := &Graph{User: user}
+	for range b.characters {
+		character := &CharacterGraph{
+			Character: characterDomain.NewCharacterEntity().WithFaker(b.faker).WithDefaults().Build(),
+		}
+		for range b.unitsPerCharacter {
+			character.Units = append(character.Units, unitDomain.NewUnitEntity().WithFaker(b.faker).WithDefaults().Build())
+		}
+
+		character.Formation = formationDomain.NewFormationEntity().WithFaker(b.faker).WithDefaults().
+			WithRows(placeUnits(character.Units)).
+			Build()
+		graph.Characters = append(graph.Characters, character)
+	}
+
+	return graph, nil
+}
+
+// Create builds the Graph and inserts it within a single transaction.
+func (b *GraphBuilder) Create(ctx context.Context, connection database.Connection) (*Graph, error) {
+	graph, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := Insert(ctx, connection, graph); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// Insert stores the graphs within a single transaction, the entities are created in the order of their foreign keys.
+// Every formation is validated against the units of its character before anything is stored.
+// The passwords of the users are stored hashed, the users within the graphs keep their plaintext passwords to log in with.
+func Insert(ctx context.Context, connection database.Connection, graphs ...*Graph) error {
+	var users []*userDomain.UserEntity
+	var characters []*characterDomain.CharacterEntity
+	var units []*unitDomain.UnitEntity
+	var formations []*formationDomain.FormationEntity
+	var userCharacters []*usercharacterDomain.UserCharacterEntity
+	var characterUnits []*characterunitDomain.CharacterUnitEntity
+	var characterFormations []*characterformationDomain.CharacterFormationEntity
+
+	hasher := passwords.Default()
+	for _, graph := range graphs {
+		user := proto.CloneOf(graph.User)
+		hashed, err := hasher.Hash(user.GetPassword())
+		if err != nil {
+			return fmt.Errorf("failed to hash password of user '%s': %w", user.GetId(), err)
+		}
+		user.Password = hashed
+		users = append(users, user)
+
+		for _, character := range graph.Characters {
+			characterId := character.Character.GetId()
+			characters = append(characters, character.Character)
+			userCharacters = append(userCharacters, usercharacterDomain.NewUserCharacterEntity().
+				WithUserID(graph.User.GetId()).
+				WithCharacterID(characterId).
+				Build())
+
+			for _, unit := range character.Units {
+				units = append(units, unit)
+				characterUnits = append(characterUnits, characterunitDomain.NewCharacterUnitEntity().
+					WithCharacterId(characterId).
+					WithUnitId(unit.GetId()).
+					Build())
+			}
+
+			if character.Formation == nil {
+				continue
+			}
+			validator := formationDomain.NewFormationValidator(formationDomain.WithOwnedUnits(character.UnitIds()...))
+			if err := validator.Validate(character.Formation); err != nil {
+				return fmt.Errorf("failed to validate formation of character '%s': %w", characterId, err)
+			}
+			formations = append(formations, character.Formation)
+			characterFormations = append(characterFormations, characterformationDomain.NewCharacterFormationEntity().
+				WithCharacterId(characterId).
+				WithId(character.Formation.GetId()).
+				Build())
+		}
+	}
+
+	return database.InTransaction(ctx, connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		if err := userinfra.NewUserRepositoryImpl(connection).Create(ctx, users...); err != nil {
+			return fmt.Errorf("failed to insert users: %w", err)
+		}
+		if err := characterinfra.NewCharacterRepositoryImpl(connection).Create(ctx, characters...); err != nil {
+			return fmt.Errorf("failed to insert characters: %w", err)
+		}
+		if err := unitinfra.NewUnitRepositoryImpl(connection).Create(ctx, units...); err != nil {
+			return fmt.Errorf("failed to insert units: %w", err)
+		}
+		if err := formationinfra.NewFormationRepositoryImpl(connection).Create(ctx, formations...); err != nil {
+			return fmt.Errorf("failed to insert formations: %w", err)
+		}
+		if err := usercharacterinfra.NewUserCharacterRepositoryImpl(connection).Create(ctx, userCharacters...); err != nil {
+			return fmt.Errorf("failed to insert user characters: %w", err)
+		}
+		if err := characterunitinfra.NewCharacterUnitRepositoryImpl(connection).Create(ctx, characterUnits...); err != nil {
+			return fmt.Errorf("failed to insert character units: %w", err)
+		}
+		if err := characterformationinfra.NewCharacterFormationRepositoryImpl(connection).Create(ctx, characterFormations...); err != nil {
+			return fmt.Errorf("failed to insert character formations: %w", err)
+		}
+		return nil
+	})
+}
+
+// UnitIds returns the ids of the units of the character
+func (c *CharacterGraph) UnitIds() []string {
+	ids := make([]string, len(c.Units))
+	for i, unit := range c.Units {
+		ids[i] = unit.GetId()
+	}
+	return ids
+}
+
+// placeUnits places the units on the formation grid row by row, units that do not fit on the grid are left out
+func placeUnits(units []*unitDomain.UnitEntity) []*formationDomain.FormationRowEntity {
+	rows := []*formationDomain.FormationRowEntity{}
+	for i, unit := range units[:min(len(units), int(formationDomain.GridWidth*formationDomain.GridHeight))] {
+		x, y := int32(i)%formationDomain.GridWidth, int32(i)/formationDomain.GridWidth
+		if x == 0 {
+			rows = append(rows, &formationDomain.FormationRowEntity{})
+		}
+		rows[y].Columns = append(rows[y].Columns, &formationDomain.FormationColumnEntity{
+			PositionX: x,
+			PositionY: y,
+			UnitId:    unit.GetId(),
+		})
+	}
+	return rows
+}
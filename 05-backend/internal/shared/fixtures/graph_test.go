@@ -0,0 +1,64 @@
+package fixtures_test
+
+import (
+	formationDomain "shvdg/crazed-conquerer/internal/domains/formation/domain"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+	"shvdg/crazed-conquerer/internal/shared/fixtures"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Graph Builder", func() {
+	When("a graph is built", func() {
+		It("should give every character its units and a valid formation placing them", func() {
+			graph, err := fixtures.NewGraph().WithCharacters(2).WithUnitsPerCharacter(7).Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(graph.User).ToNot(BeNil())
+			Expect(graph.Characters).To(HaveLen(2))
+
+			for _, character := range graph.Characters {
+				Expect(character.Units).To(HaveLen(7))
+
+				var placed []string
+				for _, row := range character.Formation.GetRows() {
+					for _, column := range row.GetColumns() {
+						placed = append(placed, column.GetUnitId())
+					}
+				}
+				Expect(placed).To(ConsistOf(character.UnitIds()))
+
+				validator := formationDomain.NewFormationValidator(formationDomain.WithOwnedUnits(character.UnitIds()...))
+				Expect(validator.Validate(character.Formation)).To(Succeed())
+			}
+		})
+
+		It("should leave out units that do not fit on the formation grid", func() {
+			graph, err := fixtures.NewGraph().WithUnitsPerCharacter(30).Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			formation := graph.Characters[0].Formation
+			Expect(formation.GetRows()).To(HaveLen(int(formationDomain.GridHeight)))
+			Expect(formationDomain.NewFormationValidator().Validate(formation)).To(Succeed())
+		})
+
+		It("should generate the same graph from the same seed", func() {
+			first, err := fixtures.NewGraph().WithFaker(faker.New(42)).Build()
+			Expect(err).ToNot(HaveOccurred())
+			second, err := fixtures.NewGraph().WithFaker(faker.New(42)).Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(second.User.GetId()).To(Equal(first.User.GetId()))
+			Expect(second.Characters[0].Character.GetId()).To(Equal(first.Characters[0].Character.GetId()))
+			Expect(second.Characters[0].UnitIds()).To(Equal(first.Characters[0].UnitIds()))
+			Expect(second.Characters[0].Formation.GetId()).To(Equal(first.Characters[0].Formation.GetId()))
+		})
+	})
+
+	When("a size is negative", func() {
+		It("should return ErrInvalidGraphSize", func() {
+			_, err := fixtures.NewGraph().WithCharacters(-1).Build()
+			Expect(err).To(MatchError(fixtures.ErrInvalidGraphSize))
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package integration
+
+import (
+	"context"
+	characterformationinfra "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
+	characterunitinfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
+	formationinfra "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
+	usercharacterinfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
+	userinfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/fixtures"
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Graph", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+	var suite *testing.Suite
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	Context("When a graph is created", func() {
+		var graph *fixtures.Graph
+
+		BeforeAll(func() {
+			graph, err = fixtures.NewGraph().WithCharacters(2).WithUnitsPerCharacter(3).Create(ctx, suite.Database)
+			Expect(err).ToNot(HaveOccurred(), "failed to create graph")
+		})
+
+		It("should store the user", func() {
+			user, err := userinfra.NewUserRepositoryImpl(suite.Database).GetByEmail(ctx, graph.User.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to get user")
+			Expect(user.GetEmail()).To(Equal(graph.User.GetEmail()))
+		})
+
+		It("should store the password of the user hashed", func() {
+			user, err := userinfra.NewUserRepositoryImpl(suite.Database).GetByEmail(ctx, graph.User.GetEmail())
+			Expect(err).ToNot(HaveOccurred(), "failed to get user")
+
+			matches, err := passwords.Default().Verify(graph.User.GetPassword(), user.GetPassword())
+			Expect(err).ToNot(HaveOccurred(), "failed to verify password")
+			Expect(matches).To(BeTrue(), "expected the plaintext password of the graph to match")
+		})
+
+		It("should link the characters to the user", func() {
+			links, err := usercharacterinfra.NewUserCharacterRepositoryImpl(suite.Database).GetByUserId(ctx, graph.User.GetId())
+			Expect(err).ToNot(HaveOccurred(), "failed to get user characters")
+			Expect(links).To(HaveLen(2))
+		})
+
+		It("should link the units and formation to every character", func() {
+			for _, character := range graph.Characters {
+				units, err := characterunitinfra.NewCharacterUnitRepositoryImpl(suite.Database).GetByCharacterId(ctx, character.Character.GetId())
+				Expect(err).ToNot(HaveOccurred(), "failed to get character units")
+				Expect(units).To(HaveLen(3))
+
+				formations, err := characterformationinfra.NewCharacterFormationRepositoryImpl(suite.Database).GetByCharacterId(ctx, character.Character.GetId())
+				Expect(err).ToNot(HaveOccurred(), "failed to get character formations")
+				Expect(formations).To(HaveLen(1))
+				Expect(formations[0].GetFormationId()).To(Equal(character.Formation.GetId()))
+			}
+		})
+
+		It("should accept updates of the formation now that its units are owned", func() {
+			formation := graph.Characters[0].Formation
+			Expect(formationinfra.NewFormationRepositoryImpl(suite.Database).Update(ctx, formation)).To(Succeed())
+		})
+	})
+
+	Context("When a graph cannot be created", func() {
+		It("should store none of it", func() {
+			graph, err := fixtures.NewGraph().Build()
+			Expect(err).ToNot(HaveOccurred(), "failed to build graph")
+			graph.Characters = append(graph.Characters, graph.Characters[0])
+
+			err = fixtures.Insert(ctx, suite.Database, graph)
+			Expect(err).To(MatchError(database.ErrDuplicate))
+
+			_, err = userinfra.NewUserRepositoryImpl(suite.Database).GetByEmail(ctx, graph.User.GetEmail())
+			Expect(err).To(MatchError(database.ErrNotFound))
+		})
+	})
+})
@@ -0,0 +1,191 @@
+package integration
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+	"shvdg/crazed-conquerer/internal/shared/schemas"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	createParentsQuery  = "CREATE TABLE migration_parents (id INT PRIMARY KEY)"
+	dropParentsQuery    = "DROP TABLE migration_parents"
+	addNameQuery        = "ALTER TABLE migration_parents ADD COLUMN name TEXT"
+	dropNameQuery       = "ALTER TABLE migration_parents DROP COLUMN name"
+	createChildrenQuery = "CREATE TABLE migration_children (id INT PRIMARY KEY, parent_id INT REFERENCES migration_parents(id))"
+	dropChildrenQuery   = "DROP TABLE migration_children"
+	countTablesQuery    = "SELECT COUNT(*) FROM information_schema.tables WHERE table_name IN ('migration_parents', 'migration_children')"
+	countColumnsQuery   = "SELECT COUNT(*) FROM information_schema.columns WHERE table_name = 'migration_parents' AND column_name = 'name'"
+)
+
+var _ = Describe("Migrator", Ordered, func() {
+	var ctx context.Context
+	var suite *testing.Suite
+
+	// domains registers the children before the parents they reference, the migrator has to reorder them.
+	// Every other domain is registered as well, as the migrator refuses to run with applied migrations it does not know.
+	domains := func(parents ...migrations.Migration) []migrations.Domain {
+		return append(schemas.All(),
+			migrations.NewDomain("migration_children", []string{"migration_parents"},
+				migrations.NewMigration(1, "create migration_children table", createChildrenQuery, dropChildrenQuery),
+			),
+			migrations.NewDomain("migration_parents", nil, parents...),
+		)
+	}
+	createParents := migrations.NewMigration(1, "create migration_parents table", createParentsQuery, dropParentsQuery)
+	addName := migrations.NewMigration(2, "add name to migration_parents", addNameQuery, dropNameQuery)
+
+	newMigrator := func(parents ...migrations.Migration) *migrations.Migrator {
+		return migrations.NewMigrator(suite.Database, migrations.WithDomains(domains(parents...)...))
+	}
+
+	// registered is the number of migrations of every other domain
+	registered := func() int {
+		total := 0
+		for _, domain := range schemas.All() {
+			total += len(domain.Migrations)
+		}
+		return total
+	}
+
+	count := func(query string) int {
+		count, err := database.QueryOne(ctx, suite.Database, query, nil, database.ScanInt)
+		Expect(err).ToNot(HaveOccurred(), "failed to count")
+		return count
+	}
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		ctx = suite.Context
+	})
+
+	AfterAll(func() {
+		_, err := newMigrator(createParents, addName).Down(ctx, 0)
+		Expect(err).ToNot(HaveOccurred(), "failed to revert migrations")
+	})
+
+	When("migrating up", func() {
+		It("should apply the domains in dependency order", func() {
+			applied, err := newMigrator(createParents).Up(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(HaveLen(2))
+			Expect(applied[0].Domain).To(Equal("migration_parents"))
+			Expect(applied[1].Domain).To(Equal("migration_children"))
+			Expect(count(countTablesQuery)).To(Equal(2))
+		})
+
+		It("should not apply migrations twice", func() {
+			applied, err := newMigrator(createParents).Up(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(BeEmpty())
+		})
+
+		It("should only apply the new migrations", func() {
+			applied, err := newMigrator(createParents, addName).Up(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(HaveLen(1))
+			Expect(applied[0].Name).To(Equal(addName.Name))
+			Expect(count(countColumnsQuery)).To(Equal(1))
+		})
+	})
+
+	When("migrating up concurrently", func() {
+		It("should apply every migration once", func() {
+			_, err := newMigrator(createParents, addName).Down(ctx, 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			var waitGroup sync.WaitGroup
+			applied := make([]int, 4)
+			errs := make([]error, 4)
+			for i := range applied {
+				waitGroup.Add(1)
+				go func() {
+					defer waitGroup.Done()
+					steps, err := newMigrator(createParents, addName).Up(ctx)
+					applied[i], errs[i] = len(steps), err
+				}()
+			}
+			waitGroup.Wait()
+
+			total := 0
+			for i := range applied {
+				Expect(errs[i]).ToNot(HaveOccurred())
+				total += applied[i]
+			}
+			Expect(total).To(Equal(registered() + 3))
+		})
+	})
+
+	When("reporting the status", func() {
+		It("should list the applied and pending migrations", func() {
+			pending := migrations.NewMigration(3, "drop name from migration_parents", dropNameQuery, addNameQuery)
+			statuses, err := newMigrator(createParents, addName, pending).Status(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			var ours []migrations.Status
+			for _, status := range statuses {
+				if status.Domain == "migration_parents" {
+					ours = append(ours, status)
+				}
+			}
+			Expect(ours).To(HaveLen(3))
+			Expect(ours[0].Applied).To(BeTrue())
+			Expect(ours[0].AppliedAt).ToNot(BeZero())
+			Expect(ours[1].Applied).To(BeTrue())
+			Expect(ours[2].Applied).To(BeFalse())
+		})
+
+		It("should flag applied migrations that are no longer registered", func() {
+			statuses, err := newMigrator(createParents).Status(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			unknown := statuses[len(statuses)-1]
+			Expect(unknown.Unknown).To(BeTrue())
+			Expect(unknown.Version).To(Equal(addName.Version))
+		})
+	})
+
+	When("an applied migration has changed", func() {
+		It("should refuse to migrate", func() {
+			changed := addName
+			changed.Up = "ALTER TABLE migration_parents ADD COLUMN name VARCHAR(255)"
+
+			_, err := newMigrator(createParents, changed).Up(ctx)
+			Expect(err).To(MatchError(migrations.ErrChecksumMismatch))
+
+			statuses, err := newMigrator(createParents, changed).Status(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statuses).To(ContainElement(HaveField("Modified", BeTrue())))
+		})
+
+		It("should refuse to migrate when an applied migration is missing", func() {
+			_, err := newMigrator(createParents).Up(ctx)
+			Expect(err).To(MatchError(migrations.ErrUnknownMigration))
+		})
+	})
+
+	When("migrating down", func() {
+		It("should revert the given number of migrations, latest first", func() {
+			reverted, err := newMigrator(createParents, addName).Down(ctx, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reverted).To(HaveLen(2))
+			Expect(reverted[0].Domain).To(Equal("migration_children"))
+			Expect(reverted[1].Name).To(Equal(addName.Name))
+			Expect(count(countColumnsQuery)).To(Equal(0))
+		})
+
+		It("should revert every migration when no number is given", func() {
+			reverted, err := newMigrator(createParents, addName).Down(ctx, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reverted).To(HaveLen(registered() + 1))
+			Expect(reverted[0].Domain).To(Equal("migration_parents"))
+			Expect(count(countTablesQuery)).To(Equal(0))
+		})
+	})
+})
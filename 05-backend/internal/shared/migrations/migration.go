@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Migration is a versioned change to the schema of a domain, Down reverts what Up applies
+type Migration struct {
+	Version int32
+	Name    string
+	Up      string
+	Down    string
+}
+
+// NewMigration creates a new instance of Migration
+func NewMigration(version int32, name, up, down string) Migration {
+	return Migration{Version: version, Name: name, Up: up, Down: down}
+}
+
+// Checksum returns the hash of the scripts of the migration, an applied migration may no longer change
+func (m Migration) Checksum() string {
+	sum := sha256.Sum256([]byte(m.Up + "\x00" + m.Down))
+	return hex.EncodeToString(sum[:])
+}
+
+// Domain contains the migrations of a single domain, which are applied after those of the domains it depends on
+type Domain struct {
+	Name       string
+	DependsOn  []string
+	Migrations []Migration
+}
+
+// NewDomain creates a new instance of Domain
+func NewDomain(name string, dependsOn []string, migrations ...Migration) Domain {
+	return Domain{Name: name, DependsOn: dependsOn, Migrations: migrations}
+}
+
+// Step is a migration together with the domain it belongs to
+type Step struct {
+	Domain string
+	Migration
+}
@@ -0,0 +1,321 @@
+package migrations
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultLockKey identifies the advisory lock that keeps migrators from running concurrently
+const DefaultLockKey int64 = 0x6d6967726174
+
+// Errors returned when the applied migrations no longer match the registered ones
+var (
+	ErrChecksumMismatch = errors.New("applied migration has changed since it was applied")
+	ErrUnknownMigration = errors.New("applied migration is not registered")
+)
+
+// Status describes whether a migration has been applied
+type Status struct {
+	Step
+	Applied   bool
+	AppliedAt time.Time
+
+	// Modified is set when the registered migration no longer matches the applied one
+	Modified bool
+	// Unknown is set when an applied migration is no longer registered
+	Unknown bool
+}
+
+// record is a migration as tracked within the schema_migrations table
+type record struct {
+	domain    string
+	version   int32
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// key identifies a migration within its domain
+type key struct {
+	domain  string
+	version int32
+}
+
+// Migrator applies and reverts the migrations of the registered domains.
+// Every migration runs in its own transaction, an advisory lock keeps concurrent migrators from interleaving.
+type Migrator struct {
+	database.Connection
+	domains []Domain
+	lockKey int64
+}
+
+// MigratorOpt configures the Migrator during initialization
+type MigratorOpt func(*Migrator)
+
+// WithDomains registers the migrations of the domains
+func WithDomains(domains ...Domain) MigratorOpt {
+	return func(m *Migrator) {
+		m.AddDomains(domains...)
+	}
+}
+
+// WithLockKey sets the key of the advisory lock held while migrating
+func WithLockKey(lockKey int64) MigratorOpt {
+	return func(m *Migrator) {
+		m.lockKey = lockKey
+	}
+}
+
+// NewMigrator creates a new instance of Migrator
+func NewMigrator(connection database.Connection, options ...MigratorOpt) *Migrator {
+	migrator := &Migrator{
+		Connection: connection,
+		lockKey:    DefaultLockKey,
+	}
+
+	for _, option := range options {
+		option(migrator)
+	}
+
+	return migrator
+}
+
+// AddDomains registers the migrations of the domains
+func (m *Migrator) AddDomains(domains ...Domain) {
+	m.domains = append(m.domains, domains...)
+}
+
+// Up applies every pending migration and returns the applied steps
+func (m *Migrator) Up(ctx context.Context) ([]Step, error) {
+	var applied []Step
+	err := m.withLock(ctx, func(connection *pgxpool.Conn) error {
+		steps, records, err := m.load(ctx, connection)
+		if err != nil {
+			return err
+		}
+
+		for _, step := range steps {
+			if _, found := records[key{step.Domain, step.Version}]; found {
+				continue
+			}
+			if err := m.apply(ctx, connection, step); err != nil {
+				return err
+			}
+			applied = append(applied, step)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the given number of applied migrations, latest first, and returns the reverted steps.
+// Every applied migration is reverted when the number is not positive.
+func (m *Migrator) Down(ctx context.Context, count int) ([]Step, error) {
+	var reverted []Step
+	err := m.withLock(ctx, func(connection *pgxpool.Conn) error {
+		steps, records, err := m.load(ctx, connection)
+		if err != nil {
+			return err
+		}
+
+		for _, step := range slices.Backward(steps) {
+			if count > 0 && len(reverted) == count {
+				break
+			}
+			if _, found := records[key{step.Domain, step.Version}]; !found {
+				continue
+			}
+			if err := m.revert(ctx, connection, step); err != nil {
+				return err
+			}
+			reverted = append(reverted, step)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status returns the state of every registered migration in the order they are applied,
+// followed by applied migrations that are no longer registered
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(connection *pgxpool.Conn) error {
+		steps, err := Plan(m.domains)
+		if err != nil {
+			return err
+		}
+		records, err := m.records(ctx, connection)
+		if err != nil {
+			return err
+		}
+
+		for _, step := range steps {
+			status := Status{Step: step}
+			if applied, found := records[key{step.Domain, step.Version}]; found {
+				status.Applied = true
+				status.AppliedAt = applied.appliedAt
+				status.Modified = applied.checksum != step.Checksum()
+				delete(records, key{step.Domain, step.Version})
+			}
+			statuses = append(statuses, status)
+		}
+
+		for _, applied := range sortedRecords(records) {
+			statuses = append(statuses, Status{
+				Step:      Step{Domain: applied.domain, Migration: Migration{Version: applied.version, Name: applied.name}},
+				Applied:   true,
+				AppliedAt: applied.appliedAt,
+				Unknown:   true,
+			})
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// withLock holds the advisory lock on a dedicated connection while the function runs on that connection,
+// so that concurrent migrators waiting for the lock do not exhaust the pool
+func (m *Migrator) withLock(ctx context.Context, function func(connection *pgxpool.Conn) error) error {
+	connection, err := m.GetPool().Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer connection.Release()
+
+	if _, err := connection.Exec(ctx, LockQuery, m.lockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		_, _ = connection.Exec(context.WithoutCancel(ctx), UnlockQuery, m.lockKey)
+	}()
+
+	err = inTransaction(ctx, connection, func(ctx context.Context) error {
+		return database.Execute(ctx, m.Connection, CreateTableQuery)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+	return function(connection)
+}
+
+// load plans the registered migrations and verifies the applied ones still match them
+func (m *Migrator) load(ctx context.Context, connection *pgxpool.Conn) ([]Step, map[key]*record, error) {
+	steps, err := Plan(m.domains)
+	if err != nil {
+		return nil, nil, err
+	}
+	records, err := m.records(ctx, connection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registered := make(map[key]bool, len(steps))
+	var errs []error
+	for _, step := range steps {
+		registered[key{step.Domain, step.Version}] = true
+		if applied, found := records[key{step.Domain, step.Version}]; found && applied.checksum != step.Checksum() {
+			errs = append(errs, fmt.Errorf("%w: '%s' version %d", ErrChecksumMismatch, step.Domain, step.Version))
+		}
+	}
+	for _, applied := range sortedRecords(records) {
+		if !registered[key{applied.domain, applied.version}] {
+			errs = append(errs, fmt.Errorf("%w: '%s' version %d", ErrUnknownMigration, applied.domain, applied.version))
+		}
+	}
+
+	return steps, records, errors.Join(errs...)
+}
+
+// records returns the applied migrations
+func (m *Migrator) records(ctx context.Context, connection *pgxpool.Conn) (map[key]*record, error) {
+	query, args := sql.NewQuery().
+		Select(FieldDomain, FieldVersion, FieldName, FieldChecksum, FieldAppliedAt).
+		From(TableName).
+		Build()
+
+	var applied []*record
+	err := inTransaction(ctx, connection, func(ctx context.Context) (err error) {
+		applied, err = database.QueryMany(ctx, m.Connection, query, args, scanRecord)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	records := make(map[key]*record, len(applied))
+	for _, r := range applied {
+		records[key{r.domain, r.version}] = r
+	}
+	return records, nil
+}
+
+// apply runs the up script of the step and tracks it as applied
+func (m *Migrator) apply(ctx context.Context, connection *pgxpool.Conn, step Step) error {
+	query, args := sql.NewQuery().
+		InsertInto(TableName).
+		InsertFields(FieldDomain, FieldVersion, FieldName, FieldChecksum).
+		Values(step.Domain, step.Version, step.Name, step.Checksum()).
+		Build()
+
+	return inTransaction(ctx, connection, func(ctx context.Context) error {
+		if err := database.Execute(ctx, m.Connection, step.Up); err != nil {
+			return fmt.Errorf("failed to apply migration '%s' version %d: %w", step.Domain, step.Version, err)
+		}
+		return database.Execute(ctx, m.Connection, query, args...)
+	})
+}
+
+// revert runs the down script of the step and no longer tracks it as applied
+func (m *Migrator) revert(ctx context.Context, connection *pgxpool.Conn, step Step) error {
+	query, args := sql.NewQuery().
+		DeleteFrom(TableName).
+		Where(FieldDomain, step.Domain).
+		Where(FieldVersion, step.Version).
+		Build()
+
+	return inTransaction(ctx, connection, func(ctx context.Context) error {
+		if err := database.Execute(ctx, m.Connection, step.Down); err != nil {
+			return fmt.Errorf("failed to revert migration '%s' version %d: %w", step.Domain, step.Version, err)
+		}
+		return database.Execute(ctx, m.Connection, query, args...)
+	})
+}
+
+// inTransaction runs the function within a transaction on the connection holding the lock
+func inTransaction(ctx context.Context, connection *pgxpool.Conn, function func(ctx context.Context) error) error {
+	return pgx.BeginFunc(ctx, connection, func(transaction pgx.Tx) error {
+		return function(contexts.SetTransaction(ctx, transaction))
+	})
+}
+
+// scanRecord scans an applied migration from a database row
+func scanRecord(scanner database.RowScanner) (*record, error) {
+	var r record
+	if err := scanner.Scan(&r.domain, &r.version, &r.name, &r.checksum, &r.appliedAt); err != nil {
+		return nil, fmt.Errorf("failed to scan migration: %w", err)
+	}
+	return &r, nil
+}
+
+// sortedRecords returns the records ordered by domain and version
+func sortedRecords(records map[key]*record) []*record {
+	sorted := make([]*record, 0, len(records))
+	for _, r := range records {
+		sorted = append(sorted, r)
+	}
+	slices.SortFunc(sorted, func(a, b *record) int {
+		return cmp.Or(strings.Compare(a.domain, b.domain), cmp.Compare(a.version, b.version))
+	})
+	return sorted
+}
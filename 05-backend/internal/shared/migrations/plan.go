@@ -0,0 +1,125 @@
+package migrations
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Errors returned when the migrations are planned
+var (
+	ErrDuplicateDomain    = errors.New("domain is registered more than once")
+	ErrUnknownDependency  = errors.New("domain depends on an unregistered domain")
+	ErrDependencyCycle    = errors.New("domains depend on each other")
+	ErrInvalidVersion     = errors.New("migration version must be positive")
+	ErrDuplicateVersion   = errors.New("migration version is used more than once")
+	ErrEmptyMigrationName = errors.New("migration has no name")
+)
+
+// Plan orders the migrations of the domains so that every domain follows the domains it depends on.
+// Domains without dependencies between them keep the order they were given in, their migrations are ordered by version.
+func Plan(domains []Domain) ([]Step, error) {
+	indices := make(map[string]int, len(domains))
+	for i, domain := range domains {
+		if _, found := indices[domain.Name]; found {
+			return nil, fmt.Errorf("%w: '%s'", ErrDuplicateDomain, domain.Name)
+		}
+		indices[domain.Name] = i
+	}
+
+	var errs []error
+	for _, domain := range domains {
+		errs = append(errs, validate(domain, indices)...)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	order, err := sortByDependencies(domains, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []Step
+	for _, i := range order {
+		migrations := slices.Clone(domains[i].Migrations)
+		slices.SortFunc(migrations, func(a, b Migration) int {
+			return cmp.Compare(a.Version, b.Version)
+		})
+		for _, migration := range migrations {
+			steps = append(steps, Step{Domain: domains[i].Name, Migration: migration})
+		}
+	}
+	return steps, nil
+}
+
+// validate returns the problems found within the dependencies and migrations of the domain
+func validate(domain Domain, indices map[string]int) []error {
+	var errs []error
+	for _, dependency := range domain.DependsOn {
+		if _, found := indices[dependency]; !found {
+			errs = append(errs, fmt.Errorf("%w: '%s' depends on '%s'", ErrUnknownDependency, domain.Name, dependency))
+		}
+	}
+
+	versions := make(map[int32]bool, len(domain.Migrations))
+	for _, migration := range domain.Migrations {
+		switch {
+		case migration.Version <= 0:
+			errs = append(errs, fmt.Errorf("%w: '%s' version %d", ErrInvalidVersion, domain.Name, migration.Version))
+		case versions[migration.Version]:
+			errs = append(errs, fmt.Errorf("%w: '%s' version %d", ErrDuplicateVersion, domain.Name, migration.Version))
+		}
+		if migration.Name == "" {
+			errs = append(errs, fmt.Errorf("%w: '%s' version %d", ErrEmptyMigrationName, domain.Name, migration.Version))
+		}
+		versions[migration.Version] = true
+	}
+	return errs
+}
+
+// sortByDependencies returns the indices of the domains in dependency order, preferring the earliest registered domain
+func sortByDependencies(domains []Domain, indices map[string]int) ([]int, error) {
+	remaining := make([]int, len(domains))
+	dependents := make([][]int, len(domains))
+	for i, domain := range domains {
+		for _, dependency := range domain.DependsOn {
+			remaining[i]++
+			dependents[indices[dependency]] = append(dependents[indices[dependency]], i)
+		}
+	}
+
+	var ready, order []int
+	for i := range domains {
+		if remaining[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		next := ready[0]
+		ready = ready[1:]
+		order = append(order, next)
+
+		for _, dependent := range dependents[next] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(order) < len(domains) {
+		var cyclic []string
+		for i, domain := range domains {
+			if remaining[i] > 0 {
+				cyclic = append(cyclic, domain.Name)
+			}
+		}
+		return nil, fmt.Errorf("%w: '%s'", ErrDependencyCycle, strings.Join(cyclic, "', '"))
+	}
+	return order, nil
+}
@@ -0,0 +1,96 @@
+package migrations
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	create := func(table string) Migration {
+		return NewMigration(1, "create "+table, "CREATE TABLE "+table+" ()", "DROP TABLE "+table)
+	}
+
+	domainsOf := func(steps []Step) []string {
+		var domains []string
+		for _, step := range steps {
+			domains = append(domains, step.Domain)
+		}
+		return domains
+	}
+
+	When("domains depend on each other", func() {
+		It("should order every domain after its dependencies", func() {
+			steps, err := Plan([]Domain{
+				NewDomain("character_units", []string{"characters", "units"}, create("character_units")),
+				NewDomain("units", nil, create("units")),
+				NewDomain("users", nil, create("users")),
+				NewDomain("characters", nil, create("characters")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainsOf(steps)).To(Equal([]string{"units", "users", "characters", "character_units"}))
+		})
+
+		It("should return ErrDependencyCycle when they depend on each other in a circle", func() {
+			_, err := Plan([]Domain{
+				NewDomain("users", nil, create("users")),
+				NewDomain("a", []string{"b"}, create("a")),
+				NewDomain("b", []string{"a"}, create("b")),
+			})
+			Expect(err).To(MatchError(ErrDependencyCycle))
+			Expect(err.Error()).To(ContainSubstring("'a', 'b'"))
+		})
+
+		It("should return ErrUnknownDependency when a dependency is not registered", func() {
+			_, err := Plan([]Domain{NewDomain("character_units", []string{"units"}, create("character_units"))})
+			Expect(err).To(MatchError(ErrUnknownDependency))
+		})
+	})
+
+	When("a domain has several migrations", func() {
+		It("should order them by version", func() {
+			steps, err := Plan([]Domain{
+				NewDomain("users", nil,
+					NewMigration(2, "add column", "ALTER TABLE users ADD COLUMN name TEXT", "ALTER TABLE users DROP COLUMN name"),
+					create("users"),
+				),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(steps).To(HaveLen(2))
+			Expect(steps[0].Version).To(Equal(int32(1)))
+			Expect(steps[1].Version).To(Equal(int32(2)))
+		})
+
+		It("should return ErrDuplicateVersion when a version is used twice", func() {
+			_, err := Plan([]Domain{NewDomain("users", nil, create("users"), create("users"))})
+			Expect(err).To(MatchError(ErrDuplicateVersion))
+		})
+
+		It("should return ErrInvalidVersion when a version is not positive", func() {
+			_, err := Plan([]Domain{NewDomain("users", nil, NewMigration(0, "create users", "", ""))})
+			Expect(err).To(MatchError(ErrInvalidVersion))
+		})
+	})
+
+	When("a domain is registered twice", func() {
+		It("should return ErrDuplicateDomain", func() {
+			_, err := Plan([]Domain{NewDomain("users", nil, create("users")), NewDomain("users", nil)})
+			Expect(err).To(MatchError(ErrDuplicateDomain))
+		})
+	})
+})
+
+var _ = Describe("Migration", func() {
+	It("should change its checksum when either script changes", func() {
+		migration := NewMigration(1, "create users", "CREATE TABLE users ()", "DROP TABLE users")
+
+		changedUp := migration
+		changedUp.Up = "CREATE TABLE users (id INT)"
+		changedDown := migration
+		changedDown.Down = "DROP TABLE IF EXISTS users"
+
+		Expect(migration.Checksum()).To(HaveLen(64))
+		Expect(migration.Checksum()).To(Equal(NewMigration(1, "renamed", migration.Up, migration.Down).Checksum()))
+		Expect(changedUp.Checksum()).ToNot(Equal(migration.Checksum()))
+		Expect(changedDown.Checksum()).ToNot(Equal(migration.Checksum()))
+	})
+})
@@ -0,0 +1,32 @@
+package migrations
+
+// Names
+const (
+	TableName = "schema_migrations"
+
+	FieldDomain    = "domain"
+	FieldVersion   = "version"
+	FieldName      = "name"
+	FieldChecksum  = "checksum"
+	FieldAppliedAt = "applied_at"
+)
+
+// SQL query constants
+const (
+	CreateTableQuery = `
+		CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+			` + FieldDomain + ` VARCHAR(255) NOT NULL,
+			` + FieldVersion + ` INT NOT NULL,
+			` + FieldName + ` VARCHAR(255) NOT NULL,
+			` + FieldChecksum + ` CHAR(64) NOT NULL,
+			` + FieldAppliedAt + ` TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (` + FieldDomain + `, ` + FieldVersion + `)
+		);
+	`
+
+	// LockQuery waits until the advisory lock of the migrator is held by the session
+	LockQuery = `SELECT pg_advisory_lock($1)`
+
+	// UnlockQuery releases the advisory lock of the migrator held by the session
+	UnlockQuery = `SELECT pg_advisory_unlock($1)`
+)
@@ -0,0 +1,225 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	zoneDomain "shvdg/crazed-conquerer/internal/domains/zone/domain"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"shvdg/crazed-conquerer/internal/shared/outbox"
+	"shvdg/crazed-conquerer/internal/shared/testing"
+	"shvdg/crazed-conquerer/internal/shared/testing/shared"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// testEvent is a minimal event with a fixed idempotency key
+type testEvent struct {
+	key, id string
+	value   int
+}
+
+func (e testEvent) Type() string           { return "test.happened" }
+func (e testEvent) AggregateID() string    { return e.id }
+func (e testEvent) Timestamp() time.Time   { return time.Now() }
+func (e testEvent) Data() any              { return map[string]int{"value": e.value} }
+func (e testEvent) IdempotencyKey() string { return e.key }
+
+var _ = Describe("Outbox", Ordered, func() {
+	var err error
+	var transaction pgx.Tx
+	var ctx context.Context
+
+	var suite *testing.Suite
+	var writer *outbox.Writer
+
+	BeforeAll(func() {
+		suite = shared.GetSharedSuite()
+		transaction, err = suite.StartTransaction()
+		Expect(err).ToNot(HaveOccurred(), "failed to start transaction")
+
+		ctx = contexts.SetTransaction(suite.Context, transaction)
+		writer = outbox.NewWriter(suite.Database)
+	})
+
+	AfterAll(func() {
+		err := transaction.Rollback(ctx)
+		Expect(err).ToNot(HaveOccurred(), "failed to rollback transaction")
+	})
+
+	countPending := func() int {
+		query := "SELECT COUNT(*) FROM " + outbox.TableName + " WHERE " + outbox.FieldPublishedAt + " IS NULL"
+		count, err := database.QueryOne(ctx, suite.Database, query, nil, database.ScanInt)
+		Expect(err).ToNot(HaveOccurred(), "failed to count pending messages")
+		return count
+	}
+
+	Context("When events are stored without a transaction", func() {
+		It("should refuse to store them", func() {
+			err := writer.Store(suite.Context, testEvent{key: "no-transaction"})
+			Expect(err).To(MatchError(outbox.ErrNoTransaction))
+		})
+	})
+
+	Context("When events are stored in a transaction that rolls back", func() {
+		It("should discard the events", func() {
+			nested, err := transaction.Begin(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to begin nested transaction")
+
+			err = writer.Store(contexts.SetTransaction(ctx, nested), testEvent{key: "rolled-back"})
+			Expect(err).ToNot(HaveOccurred(), "failed to store event")
+			Expect(nested.Rollback(ctx)).To(Succeed())
+
+			Expect(countPending()).To(Equal(0))
+		})
+	})
+
+	Context("When committed events are relayed", func() {
+		var received []events.Event
+
+		BeforeAll(func() {
+			err := writer.Store(ctx,
+				testEvent{key: "first", id: "a", value: 1},
+				testEvent{key: "second", id: "b", value: 2},
+				testEvent{key: "first", id: "a", value: 1},
+			)
+			Expect(err).ToNot(HaveOccurred(), "failed to store events")
+		})
+
+		It("should keep a single message per idempotency key", func() {
+			Expect(countPending()).To(Equal(2))
+		})
+
+		It("should publish the messages in the order they were stored", func() {
+			bus := events.NewInMemoryEventBus()
+			Expect(bus.Subscribe("test.happened", func(event events.Event) error {
+				received = append(received, event)
+				return nil
+			})).To(Succeed())
+
+			relayed, err := outbox.NewRelay(suite.Database, bus).RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(2))
+
+			Expect(received).To(HaveLen(2))
+			Expect(received[0].(*outbox.Message).IdempotencyKey()).To(Equal("first"))
+			Expect(received[1].(*outbox.Message).IdempotencyKey()).To(Equal("second"))
+			Expect(received[1].AggregateID()).To(Equal("b"))
+
+			var payload map[string]int
+			Expect(json.Unmarshal(received[1].Data().(json.RawMessage), &payload)).To(Succeed())
+			Expect(payload["value"]).To(Equal(2))
+		})
+
+		It("should not publish the messages again", func() {
+			Expect(countPending()).To(Equal(0))
+
+			relayed, err := outbox.NewRelay(suite.Database, events.NewInMemoryEventBus()).RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(0))
+		})
+	})
+
+	Context("When publishing a message fails", func() {
+		BeforeAll(func() {
+			err := writer.Store(ctx, testEvent{key: "failing"}, testEvent{key: "blocked"})
+			Expect(err).ToNot(HaveOccurred(), "failed to store events")
+		})
+
+		It("should retry the message before publishing the ones after it", func() {
+			bus := events.NewInMemoryEventBus()
+			var keys []string
+			Expect(bus.Subscribe("test.happened", func(event events.Event) error {
+				keys = append(keys, event.(*outbox.Message).IdempotencyKey())
+				if event.(*outbox.Message).IdempotencyKey() == "failing" {
+					return errors.New("unavailable")
+				}
+				return nil
+			})).To(Succeed())
+
+			relay := outbox.NewRelay(suite.Database, bus, outbox.WithMaxAttempts(2))
+			relayed, err := relay.RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(0))
+			Expect(keys).To(Equal([]string{"failing"}))
+
+			relayed, err = relay.RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(0))
+
+			relayed, err = relay.RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(1))
+			Expect(keys).To(Equal([]string{"failing", "failing", "blocked"}))
+		})
+	})
+
+	Context("When other transactions meet the advisory locks", func() {
+		tryLock := func(key int64) bool {
+			other, err := suite.StartTransaction()
+			Expect(err).ToNot(HaveOccurred(), "failed to start other transaction")
+			defer func() { Expect(other.Rollback(suite.Context)).To(Succeed()) }()
+
+			locked, err := database.QueryOne(contexts.SetTransaction(suite.Context, other), suite.Database, outbox.TryLockRelayQuery, []any{key}, database.ScanBool)
+			Expect(err).ToNot(HaveOccurred(), "failed to try advisory lock")
+			return locked
+		}
+
+		It("should keep the writers waiting until the storing transaction ends", func() {
+			Expect(writer.Store(ctx, testEvent{key: "locking"})).To(Succeed())
+			Expect(tryLock(outbox.WriteLockKey)).To(BeFalse())
+		})
+
+		It("should not publish while another relay holds the lock", func() {
+			other, err := suite.StartTransaction()
+			Expect(err).ToNot(HaveOccurred(), "failed to start other transaction")
+			defer func() { Expect(other.Rollback(suite.Context)).To(Succeed()) }()
+
+			_, err = database.QueryOne(contexts.SetTransaction(suite.Context, other), suite.Database, outbox.TryLockRelayQuery, []any{outbox.RelayLockKey}, database.ScanBool)
+			Expect(err).ToNot(HaveOccurred(), "failed to lock relays")
+
+			relayed, err := outbox.NewRelay(suite.Database, events.NewInMemoryEventBus()).RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(0))
+		})
+
+		It("should publish once the lock of the relays is free", func() {
+			relayed, err := outbox.NewRelay(suite.Database, events.NewInMemoryEventBus()).RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(1))
+		})
+	})
+
+	Context("When events are stored with the registry", func() {
+		var registry *events.Registry
+
+		BeforeAll(func() {
+			registry = events.NewRegistry()
+			Expect(zoneDomain.RegisterEvents(registry)).To(Succeed())
+
+			zone := zoneDomain.NewZoneEntity().WithDefaults().WithId("encoded-zone").Build()
+			err := outbox.NewWriter(suite.Database, outbox.WithEncoding(registry)).Store(ctx, zoneDomain.NewZoneCreated(zone))
+			Expect(err).ToNot(HaveOccurred(), "failed to store event")
+		})
+
+		It("should publish the decoded protobuf payload", func() {
+			var received []events.Event
+			bus := events.NewInMemoryEventBus()
+			Expect(bus.Subscribe(zoneDomain.ZoneCreatedEvent, func(event events.Event) error {
+				received = append(received, event)
+				return nil
+			})).To(Succeed())
+
+			relayed, err := outbox.NewRelay(suite.Database, bus, outbox.WithDecoding(registry), outbox.WithMaxAttempts(2)).RelayBatch(ctx)
+			Expect(err).ToNot(HaveOccurred(), "failed to relay batch")
+			Expect(relayed).To(Equal(1))
+			Expect(received).To(HaveLen(1))
+			Expect(received[0].Data().(*zoneDomain.ZoneCreatedPayload).GetZoneId()).To(Equal("encoded-zone"))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"time"
+)
+
+// IdempotentEvent is an event that provides its own idempotency key, so storing it twice only keeps the first
+type IdempotentEvent interface {
+	events.Event
+	IdempotencyKey() string
+}
+
+// Message is an event that was read back from the outbox, its data is the JSON payload that was stored,
+// or the decoded protobuf payload when the relay decodes messages.
+type Message struct {
+	id             int64
+	idempotencyKey string
+	eventType      string
+	aggregateId    string
+	occurredAt     time.Time
+	payload        json.RawMessage
+	attempts       int32
+	data           any
+}
+
+// Type implements events.Event
+func (m *Message) Type() string {
+	return m.eventType
+}
+
+// AggregateID implements events.Event
+func (m *Message) AggregateID() string {
+	return m.aggregateId
+}
+
+// Timestamp implements events.Event
+func (m *Message) Timestamp() time.Time {
+	return m.occurredAt
+}
+
+// Data implements events.Event, returning the decoded payload or the stored JSON payload
+func (m *Message) Data() any {
+	if m.data != nil {
+		return m.data
+	}
+	return m.payload
+}
+
+// IdempotencyKey returns the key consumers use to recognize a message they already handled
+func (m *Message) IdempotencyKey() string {
+	return m.idempotencyKey
+}
+
+// Attempts returns the number of failed attempts to publish the message
+func (m *Message) Attempts() int32 {
+	return m.attempts
+}
+
+// ScanMessage scans database row data into a Message
+func ScanMessage(scanner database.RowScanner) (*Message, error) {
+	var message Message
+
+	err := scanner.Scan(
+		&message.id,
+		&message.idempotencyKey,
+		&message.eventType,
+		&message.aggregateId,
+		&message.occurredAt,
+		&message.payload,
+		&message.attempts,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+	}
+
+	return &message, nil
+}
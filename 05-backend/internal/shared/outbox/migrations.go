@@ -0,0 +1,12 @@
+package outbox
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+)
+
+// Migrations returns the versioned changes to the outbox table
+func Migrations() migrations.Domain {
+	return migrations.NewDomain(TableName, nil,
+		migrations.NewMigration(1, "create outbox table", CreateTableQuery, DropTableQuery),
+	)
+}
@@ -0,0 +1,61 @@
+package outbox
+
+// Names
+const (
+	TableName = "outbox"
+
+	FieldId             = "id"
+	FieldIdempotencyKey = "idempotency_key"
+	FieldEventType      = "event_type"
+	FieldAggregateId    = "aggregate_id"
+	FieldOccurredAt     = "occurred_at"
+	FieldPayload        = "payload"
+	FieldAttempts       = "attempts"
+	FieldLastError      = "last_error"
+	FieldPublishedAt    = "published_at"
+	FieldCreatedAt      = "created_at"
+)
+
+// SQL query constants
+const (
+	CreateTableQuery = `
+		CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+			` + FieldId + ` BIGSERIAL PRIMARY KEY,
+			` + FieldIdempotencyKey + ` VARCHAR(255) NOT NULL UNIQUE,
+			` + FieldEventType + ` VARCHAR(255) NOT NULL,
+			` + FieldAggregateId + ` VARCHAR(255) NOT NULL,
+			` + FieldOccurredAt + ` TIMESTAMPTZ NOT NULL,
+			` + FieldPayload + ` JSONB NOT NULL DEFAULT 'null'::jsonb,
+			` + FieldAttempts + ` INT NOT NULL DEFAULT 0,
+			` + FieldLastError + ` TEXT NOT NULL DEFAULT '',
+			` + FieldPublishedAt + ` TIMESTAMPTZ,
+			` + FieldCreatedAt + ` TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		);
+		CREATE INDEX IF NOT EXISTS idx_` + TableName + `_unpublished ON ` + TableName + ` (` + FieldId + `) WHERE ` + FieldPublishedAt + ` IS NULL;
+	`
+
+	DropTableQuery = `DROP TABLE IF EXISTS ` + TableName + ` CASCADE;`
+
+	// LockWritesQuery waits until the advisory lock of the writers is held by the transaction, which releases it once it ends.
+	// Writers holding it until they commit allocate ids in commit order, so a committed message never appears behind a later id.
+	LockWritesQuery = `SELECT pg_advisory_xact_lock($1)`
+
+	// TryLockRelayQuery returns whether the advisory lock of the relays was acquired by the transaction without waiting
+	TryLockRelayQuery = `SELECT pg_try_advisory_xact_lock($1)`
+
+	// SelectPendingQuery locks the oldest unpublished messages that have attempts left, in the order they were committed
+	SelectPendingQuery = `
+		SELECT ` + FieldId + `, ` + FieldIdempotencyKey + `, ` + FieldEventType + `, ` + FieldAggregateId + `, ` + FieldOccurredAt + `, ` + FieldPayload + `, ` + FieldAttempts + `
+		FROM ` + TableName + `
+		WHERE ` + FieldPublishedAt + ` IS NULL AND ` + FieldAttempts + ` < $1
+		ORDER BY ` + FieldId + `
+		LIMIT $2
+		FOR UPDATE
+	`
+
+	// MarkPublishedQuery marks the message as published
+	MarkPublishedQuery = `UPDATE ` + TableName + ` SET ` + FieldPublishedAt + ` = NOW() WHERE ` + FieldId + ` = $1`
+
+	// MarkFailedQuery records a failed attempt to publish the message
+	MarkFailedQuery = `UPDATE ` + TableName + ` SET ` + FieldAttempts + ` = ` + FieldAttempts + ` + 1, ` + FieldLastError + ` = $2 WHERE ` + FieldId + ` = $1`
+)
@@ -0,0 +1,161 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"time"
+)
+
+// Relay defaults
+const (
+	DefaultBatchSize    int32 = 100
+	DefaultMaxAttempts  int32 = 10
+	DefaultPollInterval       = time.Second
+)
+
+// RelayLockKey identifies the advisory lock that lets a single relay publish at a time
+const RelayLockKey int64 = 0x72656c6179
+
+// Relay publishes committed outbox messages through the event bus in the order they were committed.
+// Relays running side by side take turns, only the one holding the lock of the relays publishes a batch.
+// Delivery is at-least-once: a message is published again when marking it as published fails,
+// consumers use its idempotency key to recognize duplicates.
+type Relay struct {
+	database.Connection
+	bus      events.EventBus
+	registry *events.Registry
+
+	batchSize    int32
+	maxAttempts  int32
+	pollInterval time.Duration
+}
+
+// RelayOpt configures the Relay during initialization
+type RelayOpt func(*Relay)
+
+// WithBatchSize sets the maximum number of messages published per batch
+func WithBatchSize(batchSize int32) RelayOpt {
+	return func(r *Relay) {
+		r.batchSize = max(batchSize, 1)
+	}
+}
+
+// WithMaxAttempts sets the number of failed attempts after which a message is no longer published
+func WithMaxAttempts(maxAttempts int32) RelayOpt {
+	return func(r *Relay) {
+		r.maxAttempts = max(maxAttempts, 1)
+	}
+}
+
+// WithPollInterval sets the time the relay waits between batches once the outbox is empty
+func WithPollInterval(pollInterval time.Duration) RelayOpt {
+	return func(r *Relay) {
+		r.pollInterval = pollInterval
+	}
+}
+
+// WithDecoding publishes messages with the payload decoded by the registry, for outboxes written WithEncoding
+func WithDecoding(registry *events.Registry) RelayOpt {
+	return func(r *Relay) {
+		r.registry = registry
+	}
+}
+
+// NewRelay creates a new instance of Relay
+func NewRelay(connection database.Connection, bus events.EventBus, options ...RelayOpt) *Relay {
+	relay := &Relay{
+		Connection:   connection,
+		bus:          bus,
+		batchSize:    DefaultBatchSize,
+		maxAttempts:  DefaultMaxAttempts,
+		pollInterval: DefaultPollInterval,
+	}
+
+	for _, option := range options {
+		option(relay)
+	}
+
+	return relay
+}
+
+// Run relays batches until the context is done
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		relayed, err := r.RelayBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if relayed > 0 && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.pollInterval):
+		}
+	}
+}
+
+// RelayBatch publishes the oldest pending messages and returns how many were published, nothing is published
+// while another relay holds the lock of the relays. The batch stops at the first message that fails to publish,
+// so later messages never overtake it until it runs out of attempts.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	relayed := 0
+	err := database.InTransaction(ctx, r.Connection, database.DefaultTransactionOptions(), func(ctx context.Context) error {
+		relayed = 0
+
+		locked, err := database.QueryOne(ctx, r.Connection, TryLockRelayQuery, []any{RelayLockKey}, database.ScanBool)
+		if err != nil {
+			return fmt.Errorf("failed to lock outbox relays: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		messages, err := database.QueryMany(ctx, r.Connection, SelectPendingQuery, []any{r.maxAttempts, r.batchSize}, ScanMessage)
+		if err != nil {
+			return fmt.Errorf("failed to select pending outbox messages: %w", err)
+		}
+
+		for _, message := range messages {
+			publishErr := r.decode(message)
+			if publishErr == nil {
+				publishErr = r.bus.Publish(message)
+			}
+			if publishErr != nil {
+				if err := database.Execute(ctx, r.Connection, MarkFailedQuery, message.id, publishErr.Error()); err != nil {
+					return fmt.Errorf("failed to mark outbox message '%s' as failed: %w", message.idempotencyKey, err)
+				}
+				break
+			}
+
+			if err := database.Execute(ctx, r.Connection, MarkPublishedQuery, message.id); err != nil {
+				return fmt.Errorf("failed to mark outbox message '%s' as published: %w", message.idempotencyKey, err)
+			}
+			relayed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return relayed, nil
+}
+
+// decode replaces the data of the message by the payload decoded from its envelope
+func (r *Relay) decode(message *Message) error {
+	if r.registry == nil {
+		return nil
+	}
+
+	decoded, err := r.registry.DecodeJSON(message.payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode outbox message '%s': %w", message.idempotencyKey, err)
+	}
+	message.data = decoded.Data()
+	return nil
+}
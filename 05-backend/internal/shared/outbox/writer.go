@@ -0,0 +1,100 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"shvdg/crazed-conquerer/internal/shared/contexts"
+	"shvdg/crazed-conquerer/internal/shared/database"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"shvdg/crazed-conquerer/internal/shared/sql"
+
+	"github.com/google/uuid"
+)
+
+// WriteLockKey identifies the advisory lock that serializes the writers of the outbox
+const WriteLockKey int64 = 0x6f7574626f78
+
+// ErrNoTransaction is returned when events are stored outside a transaction
+var ErrNoTransaction = errors.New("outbox requires a transaction in the context")
+
+// Writer stores events in the outbox within the transaction of the aggregate that raised them
+type Writer struct {
+	database.Connection
+	registry *events.Registry
+}
+
+// WriterOpt configures the Writer during initialization
+type WriterOpt func(*Writer)
+
+// WithEncoding stores events as envelopes serialized by the registry instead of the plain JSON of their data
+func WithEncoding(registry *events.Registry) WriterOpt {
+	return func(w *Writer) {
+		w.registry = registry
+	}
+}
+
+// NewWriter creates a new instance of Writer
+func NewWriter(connection database.Connection, options ...WriterOpt) *Writer {
+	writer := &Writer{Connection: connection}
+	for _, option := range options {
+		option(writer)
+	}
+	return writer
+}
+
+// Store adds the events to the outbox using the transaction in the context, so that they are only
+// relayed once the transaction commits and discarded when it rolls back. The transaction holds the
+// lock of the writers until it ends, so the messages of concurrent transactions are stored in commit order.
+func (w *Writer) Store(ctx context.Context, raised ...events.Event) error {
+	if len(raised) == 0 {
+		return nil
+	}
+	if contexts.GetTransaction(ctx) == nil {
+		return ErrNoTransaction
+	}
+
+	argSets := make([][]any, len(raised))
+	for i, event := range raised {
+		payload, err := w.encode(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload of event '%s': %w", event.Type(), err)
+		}
+
+		argSets[i] = []any{idempotencyKeyOf(event), event.Type(), event.AggregateID(), event.Timestamp(), json.RawMessage(payload)}
+	}
+
+	query, batchArgs := sql.NewQuery().
+		InsertInto(TableName).
+		InsertFields(FieldIdempotencyKey, FieldEventType, FieldAggregateId, FieldOccurredAt, FieldPayload).
+		BatchUpsert(argSets, []string{FieldIdempotencyKey}).
+		BuildBatch()
+
+	if err := database.Execute(ctx, w.Connection, LockWritesQuery, WriteLockKey); err != nil {
+		return fmt.Errorf("failed to lock outbox writers: %w", err)
+	}
+
+	return database.Batch(ctx, w.Connection, query, batchArgs)
+}
+
+// Record implements events.Recorder by storing the events in the outbox
+func (w *Writer) Record(ctx context.Context, raised ...events.Event) error {
+	return w.Store(ctx, raised...)
+}
+
+// encode returns the payload stored for the event
+func (w *Writer) encode(event events.Event) ([]byte, error) {
+	if w.registry != nil {
+		return w.registry.EncodeJSON(event)
+	}
+	return json.Marshal(event.Data())
+}
+
+// idempotencyKeyOf returns the key provided by the event, or a new random key
+func idempotencyKeyOf(event events.Event) string {
+	if idempotent, ok := event.(IdempotentEvent); ok && idempotent.IdempotencyKey() != "" {
+		return idempotent.IdempotencyKey()
+	}
+	return uuid.New().String()
+}
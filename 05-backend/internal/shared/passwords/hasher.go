@@ -0,0 +1,237 @@
+package passwords
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithm identifies how a password is hashed
+type Algorithm string
+
+// The algorithms a Hasher hashes and verifies passwords with
+const (
+	Argon2id Algorithm = "argon2id"
+	Bcrypt   Algorithm = "bcrypt"
+)
+
+// The parameters used when none are provided, following the OWASP recommendations for argon2id
+const (
+	DefaultMemory      uint32 = 19 * 1024
+	DefaultIterations  uint32 = 2
+	DefaultParallelism uint8  = 1
+	DefaultSaltLength  uint32 = 16
+	DefaultKeyLength   uint32 = 32
+	DefaultBcryptCost         = 12
+)
+
+// Errors returned when an encoded hash cannot be verified
+var (
+	ErrInvalidHash          = errors.New("password hash is malformed")
+	ErrUnsupportedAlgorithm = errors.New("password hash uses an unsupported algorithm")
+)
+
+// defaultHasher is the Hasher returned by Default
+var defaultHasher = NewHasher()
+
+// bcryptPrefixes are the prefixes of the bcrypt variants that can be verified
+var bcryptPrefixes = []string{"$2a$", "$2b$", "$2y$"}
+
+// Hasher hashes passwords into encoded hashes that carry their algorithm and parameters, so that
+// passwords hashed with other parameters can still be verified and recognized as in need of a rehash
+type Hasher struct {
+	algorithm   Algorithm
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	saltLength  uint32
+	keyLength   uint32
+	cost        int
+}
+
+// HasherOpt configures the Hasher during initialization
+type HasherOpt func(*Hasher)
+
+// WithAlgorithm sets the algorithm new hashes are created with
+func WithAlgorithm(algorithm Algorithm) HasherOpt {
+	return func(h *Hasher) {
+		h.algorithm = algorithm
+	}
+}
+
+// WithArgon2id sets the memory in KiB, the number of iterations and the degree of parallelism used by argon2id
+func WithArgon2id(memory, iterations uint32, parallelism uint8) HasherOpt {
+	return func(h *Hasher) {
+		h.memory = memory
+		h.iterations = iterations
+		h.parallelism = parallelism
+	}
+}
+
+// WithBcryptCost sets the cost used by bcrypt
+func WithBcryptCost(cost int) HasherOpt {
+	return func(h *Hasher) {
+		h.cost = cost
+	}
+}
+
+// NewHasher creates a new instance of Hasher, which hashes with argon2id unless configured otherwise
+func NewHasher(options ...HasherOpt) *Hasher {
+	hasher := &Hasher{
+		algorithm:   Argon2id,
+		memory:      DefaultMemory,
+		iterations:  DefaultIterations,
+		parallelism: DefaultParallelism,
+		saltLength:  DefaultSaltLength,
+		keyLength:   DefaultKeyLength,
+		cost:        DefaultBcryptCost,
+	}
+
+	for _, option := range options {
+		option(hasher)
+	}
+
+	return hasher
+}
+
+// Default returns the Hasher that hashes with argon2id using the default parameters
+func Default() *Hasher {
+	return defaultHasher
+}
+
+// Hash returns the encoded hash of the password, salted with random bytes
+func (h *Hasher) Hash(password string) (string, error) {
+	switch h.algorithm {
+	case Argon2id:
+		salt := make([]byte, h.saltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", fmt.Errorf("failed to generate salt: %w", err)
+		}
+		key := argon2.IDKey([]byte(password), salt, h.iterations, h.memory, h.parallelism, h.keyLength)
+		return encodeArgon2id(h.memory, h.iterations, h.parallelism, salt, key), nil
+	case Bcrypt:
+		hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+		if err != nil {
+			return "", fmt.Errorf("failed to hash password: %w", err)
+		}
+		return string(hashed), nil
+	default:
+		return "", fmt.Errorf("%w: '%s'", ErrUnsupportedAlgorithm, h.algorithm)
+	}
+}
+
+// Verify returns whether the password matches the encoded hash, comparing in constant time.
+// Hashes of every supported algorithm are verified, regardless of the algorithm the Hasher creates hashes with.
+func (h *Hasher) Verify(password, encoded string) (bool, error) {
+	decoded, err := decode(encoded)
+	if err != nil {
+		return false, err
+	}
+
+	switch decoded.algorithm {
+	case Argon2id:
+		key := argon2.IDKey([]byte(password), decoded.salt, decoded.iterations, decoded.memory, decoded.parallelism, uint32(len(decoded.key)))
+		return subtle.ConstantTimeCompare(key, decoded.key) == 1, nil
+	default:
+		err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+		}
+		return true, nil
+	}
+}
+
+// NeedsRehash returns whether the encoded hash was not created with the algorithm and parameters of the Hasher
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	decoded, err := decode(encoded)
+	if err != nil || decoded.algorithm != h.algorithm {
+		return true
+	}
+
+	switch decoded.algorithm {
+	case Argon2id:
+		return decoded.memory != h.memory || decoded.iterations != h.iterations || decoded.parallelism != h.parallelism ||
+			uint32(len(decoded.salt)) != h.saltLength || uint32(len(decoded.key)) != h.keyLength
+	default:
+		return decoded.cost != h.cost
+	}
+}
+
+// IsHashed returns whether the value is an encoded hash of a supported algorithm rather than a plaintext password
+func IsHashed(value string) bool {
+	_, err := decode(value)
+	return err == nil
+}
+
+// hash is an encoded hash split into its parts
+type hash struct {
+	algorithm   Algorithm
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+	cost        int
+}
+
+// encodeArgon2id encodes an argon2id key in the PHC string format
+func encodeArgon2id(memory, iterations uint32, parallelism uint8, salt, key []byte) string {
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", Argon2id, argon2.Version, memory, iterations, parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key))
+}
+
+// decode splits an encoded hash into its parts
+func decode(encoded string) (*hash, error) {
+	for _, prefix := range bcryptPrefixes {
+		if strings.HasPrefix(encoded, prefix) {
+			cost, err := bcrypt.Cost([]byte(encoded))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+			}
+			return &hash{algorithm: Bcrypt, cost: cost}, nil
+		}
+	}
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) < 2 || parts[0] != "" {
+		return nil, ErrInvalidHash
+	}
+	if Algorithm(parts[1]) != Argon2id {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnsupportedAlgorithm, parts[1])
+	}
+	if len(parts) != 6 {
+		return nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, fmt.Errorf("%w: unsupported version '%s'", ErrInvalidHash, parts[2])
+	}
+
+	decoded := &hash{algorithm: Argon2id}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &decoded.memory, &decoded.iterations, &decoded.parallelism); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+
+	var err error
+	if decoded.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+	if decoded.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHash, err)
+	}
+	if len(decoded.key) == 0 || decoded.iterations == 0 || decoded.parallelism == 0 {
+		return nil, ErrInvalidHash
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,99 @@
+package passwords_test
+
+import (
+	"shvdg/crazed-conquerer/internal/shared/passwords"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var _ = Describe("Hasher", func() {
+	var hasher *passwords.Hasher
+
+	BeforeEach(func() {
+		hasher = passwords.NewHasher(passwords.WithArgon2id(1024, 1, 1))
+	})
+
+	When("a password is hashed with argon2id", func() {
+		var encoded string
+
+		BeforeEach(func() {
+			var err error
+			encoded, err = hasher.Hash("secret")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should encode the algorithm and parameters", func() {
+			Expect(encoded).To(HavePrefix("$argon2id$v=19$m=1024,t=1,p=1$"))
+			Expect(passwords.IsHashed(encoded)).To(BeTrue())
+		})
+
+		It("should salt every hash", func() {
+			again, err := hasher.Hash("secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(again).ToNot(Equal(encoded))
+		})
+
+		It("should verify the password", func() {
+			Expect(hasher.Verify("secret", encoded)).To(BeTrue())
+			Expect(hasher.Verify("other", encoded)).To(BeFalse())
+		})
+
+		It("should verify with other parameters than those of the hasher", func() {
+			Expect(passwords.NewHasher().Verify("secret", encoded)).To(BeTrue())
+		})
+	})
+
+	When("a password is hashed with bcrypt", func() {
+		It("should verify the password", func() {
+			bcryptHasher := passwords.NewHasher(passwords.WithAlgorithm(passwords.Bcrypt), passwords.WithBcryptCost(bcrypt.MinCost))
+			encoded, err := bcryptHasher.Hash("secret")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(hasher.Verify("secret", encoded)).To(BeTrue())
+			Expect(hasher.Verify("other", encoded)).To(BeFalse())
+		})
+	})
+
+	When("the parameters of a hash differ from those of the hasher", func() {
+		It("should need a rehash", func() {
+			encoded, err := hasher.Hash("secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasher.NeedsRehash(encoded)).To(BeFalse())
+
+			Expect(passwords.NewHasher(passwords.WithArgon2id(2048, 1, 1)).NeedsRehash(encoded)).To(BeTrue())
+			Expect(passwords.NewHasher(passwords.WithAlgorithm(passwords.Bcrypt)).NeedsRehash(encoded)).To(BeTrue())
+		})
+
+		It("should need a rehash for bcrypt hashes", func() {
+			encoded, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasher.NeedsRehash(string(encoded))).To(BeTrue())
+		})
+	})
+
+	When("the value is not an encoded hash", func() {
+		It("should not be recognized as hashed", func() {
+			Expect(passwords.IsHashed("secret")).To(BeFalse())
+			Expect(passwords.IsHashed("$argon2id$v=19$m=1024,t=1,p=1$salt")).To(BeFalse())
+			Expect(passwords.IsHashed("$scrypt$ln=15,r=8,p=1$c2FsdA$a2V5")).To(BeFalse())
+		})
+
+		It("should return ErrInvalidHash when verifying", func() {
+			_, err := hasher.Verify("secret", "secret")
+			Expect(err).To(MatchError(passwords.ErrInvalidHash))
+
+			encoded, err := hasher.Hash("secret")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = hasher.Verify("secret", strings.Replace(encoded, "v=19", "v=16", 1))
+			Expect(err).To(MatchError(passwords.ErrInvalidHash))
+		})
+
+		It("should return ErrUnsupportedAlgorithm for other algorithms", func() {
+			_, err := hasher.Verify("secret", "$scrypt$ln=15,r=8,p=1$c2FsdA$a2V5")
+			Expect(err).To(MatchError(passwords.ErrUnsupportedAlgorithm))
+		})
+	})
+})
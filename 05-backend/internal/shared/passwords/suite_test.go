@@ -0,0 +1,13 @@
+package passwords_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPasswords(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Passwords Unit Tests")
+}
@@ -1,48 +1,33 @@
 package schemas
 
 import (
-	"context"
-	"fmt"
-	"shvdg/crazed-conquerer/internal/shared/database"
+	battleinfra "shvdg/crazed-conquerer/internal/domains/battle/infrastructure"
+	characterformationinfra "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
+	characterunitinfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
+	characterinfra "shvdg/crazed-conquerer/internal/domains/character/infrastructure"
+	formationinfra "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
+	sessioninfra "shvdg/crazed-conquerer/internal/domains/session/infrastructure"
+	unitinfra "shvdg/crazed-conquerer/internal/domains/unit/infrastructure"
+	usercharacterinfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
+	userinfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	zoneinfra "shvdg/crazed-conquerer/internal/domains/zone/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/migrations"
+	"shvdg/crazed-conquerer/internal/shared/outbox"
 )
 
-// Service manages connection schemas across all domains.
-type Service struct {
-	connection database.Connection
-	schemas    []database.DomainSchema
-}
-
-// NewService creates a new schema service instance.
-func NewService(db database.Connection, schemas ...database.DomainSchema) *Service {
-	return &Service{
-		connection: db,
-		schemas:    schemas,
-	}
-}
-
-// CreateAllTables creates all registered domain tables.
-func (s *Service) CreateAllTables(ctx context.Context) error {
-	for _, schema := range s.schemas {
-		if err := schema.CreateTable(ctx); err != nil {
-			return fmt.Errorf("failed to create tables: %w", err)
-		}
-	}
-
-	return nil
-}
-
-// DropAllTables drops all registered domain tables in reverse order.
-func (s *Service) DropAllTables(ctx context.Context) error {
-	for i := len(s.schemas) - 1; i >= 0; i-- {
-		if err := s.schemas[i].DropTable(ctx); err != nil {
-			return fmt.Errorf("failed to drop tables: %w", err)
-		}
+// All returns the migrations of every domain, the migrator orders them by their dependencies
+func All() []migrations.Domain {
+	return []migrations.Domain{
+		userinfra.Migrations(),
+		characterinfra.Migrations(),
+		unitinfra.Migrations(),
+		usercharacterinfra.Migrations(),
+		characterunitinfra.Migrations(),
+		formationinfra.Migrations(),
+		characterformationinfra.Migrations(),
+		zoneinfra.Migrations(),
+		battleinfra.Migrations(),
+		sessioninfra.Migrations(),
+		outbox.Migrations(),
 	}
-
-	return nil
-}
-
-// AddSchema adds a new domain schema to the service.
-func (s *Service) AddSchema(schema database.DomainSchema) {
-	s.schemas = append(s.schemas, schema)
 }
@@ -334,3 +334,9 @@ func (qb *QueryBuilder) Returning(fields ...string) *QueryBuilder {
 	qb.query.WriteString(strings.Join(fields, ", "))
 	return qb
 }
+
+// ForShare adds a FOR SHARE clause, locking the selected rows against changes until the transaction ends
+func (qb *QueryBuilder) ForShare() *QueryBuilder {
+	qb.query.WriteString(" FOR SHARE")
+	return qb
+}
@@ -78,6 +78,17 @@ var _ = Describe("QueryBuilder", func() {
 				Expect(args).To(Equal([]any{1, 2, 3}))
 			})
 
+			It("should build SELECT locking the rows for share", func() {
+				query, args := qb.Select("id").
+					From("users").
+					Where("active", true).
+					ForShare().
+					Build()
+
+				Expect(query).To(Equal("SELECT id FROM users WHERE active = $1 FOR SHARE"))
+				Expect(args).To(Equal([]any{true}))
+			})
+
 			It("should build SELECT with WHERE IN and additional WHERE", func() {
 				query, args := qb.Select("id", "name").
 					From("users").
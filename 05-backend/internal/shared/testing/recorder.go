@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"context"
+	"shvdg/crazed-conquerer/internal/shared/events"
+	"sync"
+)
+
+// EventRecorder collects the events raised by repositories and services, so tests can assert which were raised.
+type EventRecorder struct {
+	mutex  sync.Mutex
+	events []events.Event
+}
+
+// NewEventRecorder creates a new instance of EventRecorder.
+func NewEventRecorder() *EventRecorder {
+	return &EventRecorder{}
+}
+
+// Record implements events.Recorder.
+func (r *EventRecorder) Record(_ context.Context, raised ...events.Event) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = append(r.events, raised...)
+	return nil
+}
+
+// OfType returns the recorded events of the type in the order they were raised.
+func (r *EventRecorder) OfType(eventType string) []events.Event {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var matching []events.Event
+	for _, event := range r.events {
+		if event.Type() == eventType {
+			matching = append(matching, event)
+		}
+	}
+	return matching
+}
+
+// Reset forgets the recorded events.
+func (r *EventRecorder) Reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = nil
+}
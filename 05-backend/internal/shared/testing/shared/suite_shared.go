@@ -2,13 +2,7 @@ package shared
 
 import (
 	"log"
-	characterformationinfra "shvdg/crazed-conquerer/internal/domains/character-formation/infrastructure"
-	characterunitinfra "shvdg/crazed-conquerer/internal/domains/character-unit/infrastructure"
-	characterinfra "shvdg/crazed-conquerer/internal/domains/character/infrastructure"
-	formationinfra "shvdg/crazed-conquerer/internal/domains/formation/infrastructure"
-	unitinfra "shvdg/crazed-conquerer/internal/domains/unit/infrastructure"
-	usercharacterinfra "shvdg/crazed-conquerer/internal/domains/user-character/infrastructure"
-	userinfra "shvdg/crazed-conquerer/internal/domains/user/infrastructure"
+	"shvdg/crazed-conquerer/internal/shared/schemas"
 	"shvdg/crazed-conquerer/internal/shared/testing"
 	"sync"
 )
@@ -24,17 +18,11 @@ func GetSharedSuite() *testing.Suite {
 	initOnce.Do(func() {
 		log.Println("Initializing shared test suite...")
 		sharedSuite = testing.NewTestSuite()
-		sharedSuite.AddSchema(userinfra.NewUserSchema(sharedSuite.Database))
-		sharedSuite.AddSchema(characterinfra.NewCharacterSchema(sharedSuite.Database))
-		sharedSuite.AddSchema(unitinfra.NewUnitSchema(sharedSuite.Database))
-		sharedSuite.AddSchema(usercharacterinfra.NewUserCharacterSchema(sharedSuite.Database))
-		sharedSuite.AddSchema(characterunitinfra.NewCharacterUnitSchema(sharedSuite.Database))
-		sharedSuite.AddSchema(formationinfra.NewFormationSchema(sharedSuite.Database))
-		sharedSuite.AddSchema(characterformationinfra.NewCharacterFormationSchema(sharedSuite.Database))
+		sharedSuite.AddMigrations(schemas.All()...)
 
-		err := sharedSuite.CreateAllTables(sharedSuite.Context)
+		err := sharedSuite.MigrateUp(sharedSuite.Context)
 		if err != nil {
-			log.Fatalf("failed to create tables: %s", err)
+			log.Fatalf("failed to migrate database: %s", err)
 		}
 	})
 
@@ -7,8 +7,9 @@ import (
 	"shvdg/crazed-conquerer/internal/shared/containers"
 	"shvdg/crazed-conquerer/internal/shared/database"
 	"shvdg/crazed-conquerer/internal/shared/environment"
+	"shvdg/crazed-conquerer/internal/shared/faker"
+	"shvdg/crazed-conquerer/internal/shared/migrations"
 	"shvdg/crazed-conquerer/internal/shared/paths"
-	"shvdg/crazed-conquerer/internal/shared/schemas"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/joho/godotenv"
@@ -25,9 +26,9 @@ type Suite struct {
 	Postgres *containers.PostgresContainer
 	Server   *containers.ServerContainer
 
-	Database *database.Service
-	Dsn      string
-	Schemas  *schemas.Service
+	Database   *database.Service
+	Dsn        string
+	Migrations *migrations.Migrator
 }
 
 // init loads environment variables from the .tst.env file.
@@ -42,6 +43,9 @@ func init() {
 func NewTestSuite() *Suite {
 	ctx := context.Background()
 
+	seed := faker.Default().GetSeed()
+	log.Printf("generating test data with faker seed %d, set %s=%d to reproduce it", seed, environment.KeyFakerSeed, seed)
+
 	net, err := network.New(ctx)
 	if err != nil {
 		log.Fatalf("failed to create network: %s", err)
@@ -66,7 +70,7 @@ func NewTestSuite() *Suite {
 		log.Fatalf("failed to create database service: %s", err.Error())
 	}
 
-	sch := schemas.NewService(db)
+	migrator := migrations.NewMigrator(db)
 
 	return &Suite{
 		Context:    ctx,
@@ -76,7 +80,7 @@ func NewTestSuite() *Suite {
 		Server:     server,
 		Database:   db,
 		Dsn:        dsn,
-		Schemas:    sch,
+		Migrations: migrator,
 	}
 }
 
@@ -90,19 +94,21 @@ func createDefaultConfig(network string) *containers.ContainerConfig {
 	}
 }
 
-// AddSchema adds a schema to the suite.
-func (s *Suite) AddSchema(schema database.DomainSchema) {
-	s.Schemas.AddSchema(schema)
+// AddMigrations registers the migrations of the domains with the suite.
+func (s *Suite) AddMigrations(domains ...migrations.Domain) {
+	s.Migrations.AddDomains(domains...)
 }
 
-// CreateAllTables creates all registered domain tables.
-func (s *Suite) CreateAllTables(ctx context.Context) error {
-	return s.Schemas.CreateAllTables(ctx)
+// MigrateUp applies every registered migration.
+func (s *Suite) MigrateUp(ctx context.Context) error {
+	_, err := s.Migrations.Up(ctx)
+	return err
 }
 
-// DropAllTables drops all registered domain tables in reverse order.
-func (s *Suite) DropAllTables(ctx context.Context) error {
-	return s.Schemas.DropAllTables(ctx)
+// MigrateDown reverts every applied migration in reverse order.
+func (s *Suite) MigrateDown(ctx context.Context) error {
+	_, err := s.Migrations.Down(ctx, 0)
+	return err
 }
 
 // Terminate clears up resources.
@@ -111,7 +117,7 @@ func (s *Suite) Terminate() {
 		s.Server.Terminate()
 	}
 	if s.Postgres != nil {
-		_ = s.DropAllTables(s.Context)
+		_ = s.MigrateDown(s.Context)
 		s.Postgres.Terminate()
 	}
 	if s.Network != nil {
@@ -0,0 +1,27 @@
+package tokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// OpaqueTokenLength is the number of random bytes of an opaque token
+const OpaqueTokenLength = 32
+
+// NewOpaque returns a random token that carries no data, only its hash is meant to be stored
+func NewOpaque() (string, error) {
+	token := make([]byte, OpaqueTokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// HashOpaque returns the hex encoded SHA-256 hash of the token, the random bytes make a slow hash unnecessary
+func HashOpaque(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,148 @@
+package tokens
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The defaults of the Signer
+const (
+	DefaultAccessTokenTimeToLive = 15 * time.Minute
+	MinSecretLength              = 32
+)
+
+// Errors returned when a token cannot be signed or is refused
+var (
+	ErrWeakSecret   = errors.New("secret is too short to sign tokens")
+	ErrInvalidToken = errors.New("token is invalid")
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+// header is the only JOSE header the Signer creates and accepts
+var header = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Claims are the registered JWT claims carried by an access token
+type Claims struct {
+	Subject   string `json:"sub"`
+	Issuer    string `json:"iss,omitempty"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Signer signs and verifies access tokens as JWTs using HMAC-SHA256
+type Signer struct {
+	secret     []byte
+	issuer     string
+	timeToLive time.Duration
+	now        func() time.Time
+}
+
+// SignerOpt configures the Signer during initialization
+type SignerOpt func(*Signer)
+
+// WithIssuer sets the issuer claim of signed tokens, tokens of other issuers are refused
+func WithIssuer(issuer string) SignerOpt {
+	return func(s *Signer) {
+		s.issuer = issuer
+	}
+}
+
+// WithTimeToLive sets how long signed tokens remain valid
+func WithTimeToLive(timeToLive time.Duration) SignerOpt {
+	return func(s *Signer) {
+		s.timeToLive = timeToLive
+	}
+}
+
+// WithClock sets the function that tells the current time
+func WithClock(now func() time.Time) SignerOpt {
+	return func(s *Signer) {
+		s.now = now
+	}
+}
+
+// NewSigner creates a new instance of Signer, ErrWeakSecret is returned when the secret is shorter than MinSecretLength
+func NewSigner(secret []byte, options ...SignerOpt) (*Signer, error) {
+	if len(secret) < MinSecretLength {
+		return nil, fmt.Errorf("%w: should be at least %d bytes", ErrWeakSecret, MinSecretLength)
+	}
+
+	signer := &Signer{
+		secret:     secret,
+		timeToLive: DefaultAccessTokenTimeToLive,
+		now:        time.Now,
+	}
+
+	for _, option := range options {
+		option(signer)
+	}
+
+	return signer, nil
+}
+
+// GetTimeToLive returns how long signed tokens remain valid
+func (s *Signer) GetTimeToLive() time.Duration {
+	return s.timeToLive
+}
+
+// Sign returns a token for the subject that expires once its time to live has passed
+func (s *Signer) Sign(subject string) (string, *Claims, error) {
+	now := s.now()
+	claims := &Claims{
+		Subject:   subject,
+		Issuer:    s.issuer,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.timeToLive).Unix(),
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal claims: %w", err)
+	}
+
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + s.signature(unsigned), claims, nil
+}
+
+// Verify returns the claims of the token once its signature, issuer and expiry are verified.
+// ErrExpiredToken is returned for expired tokens, ErrInvalidToken for any other token that is refused.
+func (s *Signer) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != header {
+		return nil, ErrInvalidToken
+	}
+
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signature(parts[0]+"."+parts[1]))) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if claims.Subject == "" || claims.Issuer != s.issuer {
+		return nil, ErrInvalidToken
+	}
+	if !s.now().Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, ErrExpiredToken
+	}
+	return &claims, nil
+}
+
+// signature returns the encoded HMAC-SHA256 of the unsigned token
+func (s *Signer) signature(unsigned string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,111 @@
+package tokens_test
+
+import (
+	"encoding/base64"
+	"shvdg/crazed-conquerer/internal/shared/tokens"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signer", func() {
+	secret := []byte("a-secret-that-is-long-enough-to-sign")
+
+	It("should refuse a short secret", func() {
+		_, err := tokens.NewSigner([]byte("short"))
+		Expect(err).To(MatchError(tokens.ErrWeakSecret))
+	})
+
+	It("should verify the tokens it signed", func() {
+		signer, err := tokens.NewSigner(secret, tokens.WithIssuer("crazed-conquerer"))
+		Expect(err).ToNot(HaveOccurred())
+
+		token, signed, err := signer.Sign("user-id")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signed.ExpiresAt - signed.IssuedAt).To(BeEquivalentTo(tokens.DefaultAccessTokenTimeToLive.Seconds()))
+
+		claims, err := signer.Verify(token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(claims).To(Equal(signed))
+	})
+
+	It("should refuse tokens signed with another secret", func() {
+		signer, _ := tokens.NewSigner(secret)
+		other, _ := tokens.NewSigner([]byte("another-secret-that-is-long-enough"))
+
+		token, _, err := other.Sign("user-id")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = signer.Verify(token)
+		Expect(err).To(MatchError(tokens.ErrInvalidToken))
+	})
+
+	It("should refuse tokens of another issuer", func() {
+		signer, _ := tokens.NewSigner(secret, tokens.WithIssuer("a"))
+		other, _ := tokens.NewSigner(secret, tokens.WithIssuer("b"))
+
+		token, _, _ := other.Sign("user-id")
+		_, err := signer.Verify(token)
+		Expect(err).To(MatchError(tokens.ErrInvalidToken))
+	})
+
+	It("should refuse tokens with a tampered payload", func() {
+		signer, _ := tokens.NewSigner(secret)
+		token, _, _ := signer.Sign("user-id")
+
+		parts := strings.Split(token, ".")
+		parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"other-id","iat":0,"exp":9999999999}`))
+
+		_, err := signer.Verify(strings.Join(parts, "."))
+		Expect(err).To(MatchError(tokens.ErrInvalidToken))
+	})
+
+	It("should refuse tokens with another algorithm", func() {
+		signer, _ := tokens.NewSigner(secret)
+		token, _, _ := signer.Sign("user-id")
+
+		parts := strings.Split(token, ".")
+		parts[0] = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+
+		_, err := signer.Verify(strings.Join(parts[:2], ".") + ".")
+		Expect(err).To(MatchError(tokens.ErrInvalidToken))
+	})
+
+	It("should refuse malformed tokens", func() {
+		signer, _ := tokens.NewSigner(secret)
+		for _, token := range []string{"", "a.b", "a.b.c.d", "not a token"} {
+			_, err := signer.Verify(token)
+			Expect(err).To(MatchError(tokens.ErrInvalidToken), token)
+		}
+	})
+
+	It("should refuse expired tokens", func() {
+		now := time.Now()
+		signer, _ := tokens.NewSigner(secret, tokens.WithTimeToLive(time.Minute), tokens.WithClock(func() time.Time { return now }))
+		token, _, _ := signer.Sign("user-id")
+
+		now = now.Add(time.Minute)
+		_, err := signer.Verify(token)
+		Expect(err).To(MatchError(tokens.ErrExpiredToken))
+	})
+})
+
+var _ = Describe("Opaque", func() {
+	It("should generate unique tokens", func() {
+		first, err := tokens.NewOpaque()
+		Expect(err).ToNot(HaveOccurred())
+		second, err := tokens.NewOpaque()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(first).ToNot(Equal(second))
+		Expect(base64.RawURLEncoding.DecodeString(first)).To(HaveLen(tokens.OpaqueTokenLength))
+	})
+
+	It("should hash tokens deterministically", func() {
+		token, _ := tokens.NewOpaque()
+		Expect(tokens.HashOpaque(token)).To(Equal(tokens.HashOpaque(token)))
+		Expect(tokens.HashOpaque(token)).To(HaveLen(64))
+	})
+})
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"math"
+)
+
+// The zone map is a grid of flat-topped hexagons where every odd column is shifted half a tile down.
+// Coordinates are expressed in these offset coordinates (X being the column and Y the row), while
+// the geometry itself is calculated in axial coordinates, see https://www.redblobgames.com/grids/hexagons/
+
+// HexDirections contains the six hex directions in clockwise order, starting at the north
+var HexDirections = []HexDirection{
+	HexDirection_HEX_DIRECTION_NORTH,
+	HexDirection_HEX_DIRECTION_NORTH_EAST,
+	HexDirection_HEX_DIRECTION_SOUTH_EAST,
+	HexDirection_HEX_DIRECTION_SOUTH,
+	HexDirection_HEX_DIRECTION_SOUTH_WEST,
+	HexDirection_HEX_DIRECTION_NORTH_WEST,
+}
+
+// axialDirections contains the axial offset of a neighbour for each hex direction
+var axialDirections = map[HexDirection]AxialCoordinates{
+	HexDirection_HEX_DIRECTION_NORTH:      {Q: 0, R: -1},
+	HexDirection_HEX_DIRECTION_NORTH_EAST: {Q: 1, R: -1},
+	HexDirection_HEX_DIRECTION_SOUTH_EAST: {Q: 1, R: 0},
+	HexDirection_HEX_DIRECTION_SOUTH:      {Q: 0, R: 1},
+	HexDirection_HEX_DIRECTION_SOUTH_WEST: {Q: -1, R: 1},
+	HexDirection_HEX_DIRECTION_NORTH_WEST: {Q: -1, R: 0},
+}
+
+// AxialCoordinates represents a position on the hex grid in axial coordinates
+type AxialCoordinates struct {
+	Q int32
+	R int32
+}
+
+// NewAxialCoordinates initializes a new AxialCoordinates object.
+func NewAxialCoordinates(q, r int32) AxialCoordinates {
+	return AxialCoordinates{Q: q, R: r}
+}
+
+// S returns the third cube coordinate, which is implied by Q and R
+func (a AxialCoordinates) S() int32 {
+	return -a.Q - a.R
+}
+
+// Add returns the sum of both axial coordinates
+func (a AxialCoordinates) Add(other AxialCoordinates) AxialCoordinates {
+	return AxialCoordinates{Q: a.Q + other.Q, R: a.R + other.R}
+}
+
+// Subtract returns the difference between both axial coordinates
+func (a AxialCoordinates) Subtract(other AxialCoordinates) AxialCoordinates {
+	return AxialCoordinates{Q: a.Q - other.Q, R: a.R - other.R}
+}
+
+// Scale returns the axial coordinates multiplied by the factor
+func (a AxialCoordinates) Scale(factor int32) AxialCoordinates {
+	return AxialCoordinates{Q: a.Q * factor, R: a.R * factor}
+}
+
+// Length returns the number of steps between the axial coordinates and the origin
+func (a AxialCoordinates) Length() int32 {
+	return max(absolute(a.Q), absolute(a.R), absolute(a.S()))
+}
+
+// RotateClockwise returns the axial coordinates rotated around the origin by 60 degrees per step
+func (a AxialCoordinates) RotateClockwise(steps int) AxialCoordinates {
+	rotated := a
+	for range ((steps % 6) + 6) % 6 {
+		rotated = AxialCoordinates{Q: -rotated.R, R: -rotated.S()}
+	}
+	return rotated
+}
+
+// ToCoordinates converts the axial coordinates into offset coordinates
+func (a AxialCoordinates) ToCoordinates() *Coordinates {
+	return NewCoordinates(a.Q, a.R+(a.Q-(a.Q&1))/2)
+}
+
+// ToAxial converts the offset coordinates into axial coordinates
+func (c *Coordinates) ToAxial() AxialCoordinates {
+	return NewAxialCoordinates(c.X, c.Y-(c.X-(c.X&1))/2)
+}
+
+// Neighbour returns the coordinates of the adjacent hex in the given direction
+func (c *Coordinates) Neighbour(direction HexDirection) *Coordinates {
+	return c.ToAxial().Add(axialDirections[direction]).ToCoordinates()
+}
+
+// Neighbours returns the coordinates of all six adjacent hexes in clockwise order, starting at the north
+func (c *Coordinates) Neighbours() []*Coordinates {
+	neighbours := make([]*Coordinates, 0, len(HexDirections))
+	for _, direction := range HexDirections {
+		neighbours = append(neighbours, c.Neighbour(direction))
+	}
+	return neighbours
+}
+
+// DistanceTo returns the number of hex steps between both coordinates
+func (c *Coordinates) DistanceTo(other *Coordinates) int32 {
+	return c.ToAxial().Subtract(other.ToAxial()).Length()
+}
+
+// LineTo returns every hex on the straight line towards the other coordinates, including both ends
+func (c *Coordinates) LineTo(other *Coordinates) []*Coordinates {
+	start, end := c.ToAxial(), other.ToAxial()
+	distance := start.Subtract(end).Length()
+
+	line := make([]*Coordinates, 0, distance+1)
+	for step := range distance + 1 {
+		t := 0.0
+		if distance > 0 {
+			t = float64(step) / float64(distance)
+		}
+
+		// The nudge keeps points exactly between two hexes from flipping sides
+		q := lerp(float64(start.Q)+1e-6, float64(end.Q)+1e-6, t)
+		r := lerp(float64(start.R)+1e-6, float64(end.R)+1e-6, t)
+		line = append(line, roundAxial(q, r).ToCoordinates())
+	}
+	return line
+}
+
+// RotateAround returns the coordinates rotated around the center by 60 degrees clockwise per step
+func (c *Coordinates) RotateAround(center *Coordinates, steps int) *Coordinates {
+	origin := center.ToAxial()
+	return c.ToAxial().Subtract(origin).RotateClockwise(steps).Add(origin).ToCoordinates()
+}
+
+// Ring returns every hex at exactly the radius from the center, clockwise starting at the north
+func Ring(center *Coordinates, radius int32) []*Coordinates {
+	if radius <= 0 {
+		return []*Coordinates{NewCoordinates(center.X, center.Y)}
+	}
+
+	ring := make([]*Coordinates, 0, 6*radius)
+	current := center.ToAxial().Add(axialDirections[HexDirection_HEX_DIRECTION_NORTH].Scale(radius))
+	for i := range HexDirections {
+		// Walking along an edge of the ring happens two directions further along than the corner it started at
+		step := axialDirections[HexDirections[(i+2)%len(HexDirections)]]
+		for range radius {
+			ring = append(ring, current.ToCoordinates())
+			current = current.Add(step)
+		}
+	}
+	return ring
+}
+
+// Spiral returns every hex within the radius from the center, ring by ring starting at the center
+func Spiral(center *Coordinates, radius int32) []*Coordinates {
+	spiral := make([]*Coordinates, 0, 1+3*radius*(radius+1))
+	for distance := int32(0); distance <= radius; distance++ {
+		spiral = append(spiral, Ring(center, distance)...)
+	}
+	return spiral
+}
+
+// roundAxial returns the hex that contains the fractional axial coordinates
+func roundAxial(q, r float64) AxialCoordinates {
+	s := -q - r
+	roundedQ, roundedR, roundedS := math.Round(q), math.Round(r), math.Round(s)
+	deltaQ, deltaR, deltaS := math.Abs(roundedQ-q), math.Abs(roundedR-r), math.Abs(roundedS-s)
+
+	switch {
+	case deltaQ > deltaR && deltaQ > deltaS:
+		roundedQ = -roundedR - roundedS
+	case deltaR > deltaS:
+		roundedR = -roundedQ - roundedS
+	}
+	return NewAxialCoordinates(int32(roundedQ), int32(roundedR))
+}
+
+// lerp returns the value at fraction t between a and b
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// absolute returns the absolute value of the given number
+func absolute(value int32) int32 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
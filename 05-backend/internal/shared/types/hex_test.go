@@ -0,0 +1,145 @@
+package types
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// position flattens the coordinates into a comparable pair
+func position(coordinates *Coordinates) [2]int32 {
+	return [2]int32{coordinates.X, coordinates.Y}
+}
+
+// positions flattens the coordinates into comparable pairs
+func positions(coordinates []*Coordinates) [][2]int32 {
+	flattened := make([][2]int32, 0, len(coordinates))
+	for _, c := range coordinates {
+		flattened = append(flattened, position(c))
+	}
+	return flattened
+}
+
+var _ = Describe("Hex", func() {
+	Context("When converting between offset and axial coordinates", func() {
+		It("should convert back to the same offset coordinates", func() {
+			for x := int32(-4); x <= 4; x++ {
+				for y := int32(-4); y <= 4; y++ {
+					converted := NewCoordinates(x, y).ToAxial().ToCoordinates()
+					Expect(converted.X).To(Equal(x))
+					Expect(converted.Y).To(Equal(y))
+				}
+			}
+		})
+
+		It("should shift the odd columns half a tile down", func() {
+			Expect(NewCoordinates(1, 0).ToAxial()).To(Equal(NewAxialCoordinates(1, 0)))
+			Expect(NewCoordinates(2, 0).ToAxial()).To(Equal(NewAxialCoordinates(2, -1)))
+			Expect(NewCoordinates(-1, 0).ToAxial()).To(Equal(NewAxialCoordinates(-1, 1)))
+		})
+	})
+
+	Context("When looking up neighbours", func() {
+		It("should return the neighbours of an even column", func() {
+			Expect(positions(NewCoordinates(2, 2).Neighbours())).To(Equal([][2]int32{
+				{2, 1}, {3, 1}, {3, 2}, {2, 3}, {1, 2}, {1, 1},
+			}))
+		})
+
+		It("should return the neighbours of an odd column", func() {
+			Expect(positions(NewCoordinates(1, 1).Neighbours())).To(Equal([][2]int32{
+				{1, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1},
+			}))
+		})
+
+		It("should be at a distance of one", func() {
+			origin := NewCoordinates(3, -2)
+			for _, neighbour := range origin.Neighbours() {
+				Expect(origin.DistanceTo(neighbour)).To(Equal(int32(1)))
+			}
+		})
+	})
+
+	Context("When calculating distances", func() {
+		It("should count the hex steps between both coordinates", func() {
+			Expect(NewCoordinates(0, 0).DistanceTo(NewCoordinates(0, 0))).To(BeZero())
+			Expect(NewCoordinates(0, 0).DistanceTo(NewCoordinates(0, 3))).To(Equal(int32(3)))
+			Expect(NewCoordinates(0, 0).DistanceTo(NewCoordinates(3, 0))).To(Equal(int32(3)))
+			Expect(NewCoordinates(0, 0).DistanceTo(NewCoordinates(4, 4))).To(Equal(int32(6)))
+		})
+
+		It("should be symmetric", func() {
+			a, b := NewCoordinates(-3, 5), NewCoordinates(4, -1)
+			Expect(a.DistanceTo(b)).To(Equal(b.DistanceTo(a)))
+		})
+	})
+
+	Context("When drawing lines", func() {
+		It("should include both ends and connect neighbouring hexes", func() {
+			start, end := NewCoordinates(0, 0), NewCoordinates(5, 2)
+			line := start.LineTo(end)
+
+			Expect(line).To(HaveLen(int(start.DistanceTo(end)) + 1))
+			Expect(positions(line[:1])).To(Equal([][2]int32{{0, 0}}))
+			Expect(positions(line[len(line)-1:])).To(Equal([][2]int32{{5, 2}}))
+			for i := 1; i < len(line); i++ {
+				Expect(line[i-1].DistanceTo(line[i])).To(Equal(int32(1)))
+			}
+		})
+
+		It("should return a single hex when both ends are the same", func() {
+			Expect(positions(NewCoordinates(2, 2).LineTo(NewCoordinates(2, 2)))).To(Equal([][2]int32{{2, 2}}))
+		})
+	})
+
+	Context("When building rings and spirals", func() {
+		It("should return only the center for a radius of zero", func() {
+			Expect(positions(Ring(NewCoordinates(1, 1), 0))).To(Equal([][2]int32{{1, 1}}))
+		})
+
+		It("should return every hex at exactly the radius", func() {
+			center := NewCoordinates(1, 2)
+			ring := Ring(center, 2)
+
+			Expect(ring).To(HaveLen(12))
+			Expect(positions(ring[:1])).To(Equal([][2]int32{{1, 0}}))
+			for _, hex := range ring {
+				Expect(center.DistanceTo(hex)).To(Equal(int32(2)))
+			}
+		})
+
+		It("should return the same hexes as the neighbours for a radius of one", func() {
+			center := NewCoordinates(3, 3)
+			Expect(positions(Ring(center, 1))).To(Equal(positions(center.Neighbours())))
+		})
+
+		It("should return every hex within the radius without duplicates", func() {
+			spiral := Spiral(NewCoordinates(0, 0), 3)
+
+			Expect(spiral).To(HaveLen(37))
+			Expect(positions(spiral)).To(ContainElement([2]int32{0, 0}))
+			seen := make(map[[2]int32]bool)
+			for _, position := range positions(spiral) {
+				Expect(seen).NotTo(HaveKey(position))
+				seen[position] = true
+			}
+		})
+	})
+
+	Context("When rotating", func() {
+		It("should move a neighbour to the next direction for every step", func() {
+			center := NewCoordinates(2, 2)
+			north := center.Neighbour(HexDirection_HEX_DIRECTION_NORTH)
+
+			Expect(position(north.RotateAround(center, 1))).To(Equal(position(center.Neighbour(HexDirection_HEX_DIRECTION_NORTH_EAST))))
+			Expect(position(north.RotateAround(center, -1))).To(Equal(position(center.Neighbour(HexDirection_HEX_DIRECTION_NORTH_WEST))))
+			Expect(position(north.RotateAround(center, 6))).To(Equal(position(north)))
+		})
+
+		It("should keep the distance to the center", func() {
+			center, hex := NewCoordinates(-1, 4), NewCoordinates(3, 1)
+			for steps := range 6 {
+				Expect(hex.RotateAround(center, steps).DistanceTo(center)).To(Equal(hex.DistanceTo(center)))
+			}
+		})
+	})
+})
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"container/heap"
+)
+
+// Graph describes how positions connect and what it costs to step onto them
+type Graph interface {
+	// Neighbours returns the positions that can be reached from the position with a single step
+	Neighbours(position *Coordinates) []*Coordinates
+	// Cost returns the cost of stepping onto the position, a negative cost marks the position as blocked
+	Cost(position *Coordinates) int32
+	// Estimate returns a lower bound of the cost between both positions, zero turns the search into Dijkstra
+	Estimate(from, to *Coordinates) int32
+}
+
+// CostFunc returns the cost of stepping onto the position, a negative cost marks the position as blocked
+type CostFunc func(position *Coordinates) int32
+
+// HexGraph is a Graph over the hex grid, every step is expected to cost at least one
+type HexGraph struct {
+	cost CostFunc
+}
+
+// NewHexGraph creates a new instance of HexGraph
+func NewHexGraph(cost CostFunc) *HexGraph {
+	return &HexGraph{cost: cost}
+}
+
+// Neighbours implements Graph.Neighbours
+func (g *HexGraph) Neighbours(position *Coordinates) []*Coordinates {
+	return position.Neighbours()
+}
+
+// Cost implements Graph.Cost
+func (g *HexGraph) Cost(position *Coordinates) int32 {
+	return g.cost(position)
+}
+
+// Estimate implements Graph.Estimate
+func (g *HexGraph) Estimate(from, to *Coordinates) int32 {
+	return from.DistanceTo(to)
+}
+
+// FindPath returns the cheapest path from the start to the goal including both ends, together with its cost.
+// Positions that cost more than the budget to reach are not explored, which keeps the search finite.
+func FindPath(graph Graph, start, goal *Coordinates, budget int32) ([]*Coordinates, int32, bool) {
+	search := newSearch(start)
+	goalKey := keyOf(goal)
+
+	for current := search.next(); current != nil; current = search.next() {
+		if current.key == goalKey {
+			return search.pathTo(current), current.cost, true
+		}
+
+		search.expand(graph, current, budget, func(position *Coordinates) int32 {
+			return graph.Estimate(position, goal)
+		})
+	}
+
+	return nil, 0, false
+}
+
+// FindReachable returns every position that can be reached from the start within the budget, excluding the start.
+// The positions are ordered by their cost, positions with the same cost keep the order in which they were found.
+func FindReachable(graph Graph, start *Coordinates, budget int32) []*Coordinates {
+	search := newSearch(start)
+
+	var reachable []*Coordinates
+	for current := search.next(); current != nil; current = search.next() {
+		if current.parent != nil {
+			reachable = append(reachable, current.position)
+		}
+
+		search.expand(graph, current, budget, func(*Coordinates) int32 {
+			return 0
+		})
+	}
+
+	return reachable
+}
+
+// positionKey identifies a position within the maps of a search
+type positionKey struct {
+	x, y int32
+}
+
+// keyOf returns the key of the position
+func keyOf(position *Coordinates) positionKey {
+	return positionKey{x: position.X, y: position.Y}
+}
+
+// searchNode is a position discovered during a search
+type searchNode struct {
+	key      positionKey
+	position *Coordinates
+	parent   *searchNode
+	cost     int32
+	priority int32
+	sequence int
+}
+
+// search contains the state shared by the pathfinding algorithms
+type search struct {
+	frontier frontier
+	costs    map[positionKey]int32
+	closed   map[positionKey]bool
+	sequence int
+}
+
+// newSearch initializes a new search that starts at the position
+func newSearch(start *Coordinates) *search {
+	s := &search{
+		costs:  make(map[positionKey]int32),
+		closed: make(map[positionKey]bool),
+	}
+	s.push(&searchNode{key: keyOf(start), position: NewCoordinates(start.X, start.Y)})
+	return s
+}
+
+// push adds the node to the frontier, nodes pushed earlier win ties
+func (s *search) push(node *searchNode) {
+	node.sequence = s.sequence
+	s.sequence++
+	s.costs[node.key] = node.cost
+	heap.Push(&s.frontier, node)
+}
+
+// next returns the cheapest node that has not been closed yet, or nil once the frontier is exhausted
+func (s *search) next() *searchNode {
+	for s.frontier.Len() > 0 {
+		node := heap.Pop(&s.frontier).(*searchNode)
+		if !s.closed[node.key] {
+			s.closed[node.key] = true
+			return node
+		}
+	}
+	return nil
+}
+
+// expand discovers the neighbours of the node that are not blocked and remain within the budget
+func (s *search) expand(graph Graph, current *searchNode, budget int32, estimate func(*Coordinates) int32) {
+	for _, neighbour := range graph.Neighbours(current.position) {
+		key := keyOf(neighbour)
+		if s.closed[key] {
+			continue
+		}
+
+		stepCost := graph.Cost(neighbour)
+		if stepCost < 0 {
+			continue
+		}
+
+		cost := current.cost + stepCost
+		if cost > budget {
+			continue
+		}
+		if known, found := s.costs[key]; found && known <= cost {
+			continue
+		}
+
+		s.push(&searchNode{
+			key:      key,
+			position: neighbour,
+			parent:   current,
+			cost:     cost,
+			priority: cost + estimate(neighbour),
+		})
+	}
+}
+
+// pathTo returns the positions from the start of the search up to and including the node
+func (s *search) pathTo(node *searchNode) []*Coordinates {
+	var path []*Coordinates
+	for current := node; current != nil; current = current.parent {
+		path = append(path, current.position)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// frontier is a priority queue of search nodes, implementing heap.Interface
+type frontier []*searchNode
+
+// Len implements heap.Interface
+func (f frontier) Len() int { return len(f) }
+
+// Less implements heap.Interface
+func (f frontier) Less(i, j int) bool {
+	if f[i].priority != f[j].priority {
+		return f[i].priority < f[j].priority
+	}
+	return f[i].sequence < f[j].sequence
+}
+
+// Swap implements heap.Interface
+func (f frontier) Swap(i, j int) { f[i], f[j] = f[j], f[i] }
+
+// Push implements heap.Interface
+func (f *frontier) Push(node any) { *f = append(*f, node.(*searchNode)) }
+
+// Pop implements heap.Interface
+func (f *frontier) Pop() any {
+	old := *f
+	node := old[len(old)-1]
+	*f = old[:len(old)-1]
+	return node
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// boundedCosts returns a CostFunc for a hex grid of the given size, where the blocked positions cannot be entered
+func boundedCosts(width, height int32, costs map[[2]int32]int32) CostFunc {
+	return func(position *Coordinates) int32 {
+		if position.X < 0 || position.Y < 0 || position.X >= width || position.Y >= height {
+			return -1
+		}
+		if cost, found := costs[[2]int32{position.X, position.Y}]; found {
+			return cost
+		}
+		return 1
+	}
+}
+
+var _ = Describe("Pathfinding", func() {
+	Context("When finding a path on an open grid", func() {
+		It("should take the shortest route", func() {
+			graph := NewHexGraph(boundedCosts(6, 6, nil))
+			start, goal := NewCoordinates(0, 0), NewCoordinates(4, 3)
+
+			path, cost, found := FindPath(graph, start, goal, 100)
+
+			Expect(found).To(BeTrue())
+			Expect(cost).To(Equal(start.DistanceTo(goal)))
+			Expect(path).To(HaveLen(int(cost) + 1))
+			Expect(position(path[0])).To(Equal(position(start)))
+			Expect(position(path[len(path)-1])).To(Equal(position(goal)))
+			for i := 1; i < len(path); i++ {
+				Expect(path[i-1].DistanceTo(path[i])).To(Equal(int32(1)))
+			}
+		})
+
+		It("should return only the start when it is the goal", func() {
+			path, cost, found := FindPath(NewHexGraph(boundedCosts(3, 3, nil)), NewCoordinates(1, 1), NewCoordinates(1, 1), 0)
+
+			Expect(found).To(BeTrue())
+			Expect(cost).To(BeZero())
+			Expect(positions(path)).To(Equal([][2]int32{{1, 1}}))
+		})
+	})
+
+	Context("When the grid contains costly and blocked tiles", func() {
+		It("should route around a wall", func() {
+			wall := map[[2]int32]int32{{2, 0}: -1, {2, 1}: -1, {2, 2}: -1, {2, 3}: -1}
+			graph := NewHexGraph(boundedCosts(5, 5, wall))
+
+			path, cost, found := FindPath(graph, NewCoordinates(0, 1), NewCoordinates(4, 1), 100)
+
+			Expect(found).To(BeTrue())
+			Expect(cost).To(BeNumerically(">", NewCoordinates(0, 1).DistanceTo(NewCoordinates(4, 1))))
+			Expect(positions(path)).To(ContainElement([2]int32{2, 4}))
+		})
+
+		It("should prefer a longer but cheaper route", func() {
+			swamp := map[[2]int32]int32{{1, 0}: 9, {1, 1}: 9}
+			graph := NewHexGraph(boundedCosts(3, 3, swamp))
+
+			path, cost, found := FindPath(graph, NewCoordinates(0, 0), NewCoordinates(2, 0), 100)
+
+			Expect(found).To(BeTrue())
+			Expect(cost).To(BeNumerically("<", 9))
+			Expect(positions(path)).ToNot(ContainElement([2]int32{1, 0}))
+		})
+
+		It("should fail when the goal is blocked", func() {
+			graph := NewHexGraph(boundedCosts(3, 3, map[[2]int32]int32{{2, 2}: -1}))
+
+			_, _, found := FindPath(graph, NewCoordinates(0, 0), NewCoordinates(2, 2), 100)
+			Expect(found).To(BeFalse())
+		})
+
+		It("should fail when the goal lies beyond the budget", func() {
+			graph := NewHexGraph(boundedCosts(6, 6, nil))
+
+			_, _, found := FindPath(graph, NewCoordinates(0, 0), NewCoordinates(5, 5), 3)
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("When finding the reachable positions", func() {
+		It("should return every position within the budget on an open grid", func() {
+			center := NewCoordinates(5, 5)
+			reachable := FindReachable(NewHexGraph(boundedCosts(11, 11, nil)), center, 2)
+
+			Expect(reachable).To(HaveLen(18))
+			Expect(positions(reachable)).ToNot(ContainElement(position(center)))
+			for _, hex := range reachable {
+				Expect(center.DistanceTo(hex)).To(BeNumerically("<=", 2))
+			}
+		})
+
+		It("should leave out positions that are blocked or too costly", func() {
+			costs := map[[2]int32]int32{{5, 4}: -1, {6, 5}: 3}
+			reachable := FindReachable(NewHexGraph(boundedCosts(11, 11, costs)), NewCoordinates(5, 5), 1)
+
+			Expect(reachable).To(HaveLen(4))
+			Expect(positions(reachable)).ToNot(ContainElements([2]int32{5, 4}, [2]int32{6, 5}))
+		})
+
+		It("should order the positions by their cost", func() {
+			reachable := FindReachable(NewHexGraph(boundedCosts(11, 11, nil)), NewCoordinates(5, 5), 2)
+
+			for i := 1; i < len(reachable); i++ {
+				Expect(NewCoordinates(5, 5).DistanceTo(reachable[i-1])).To(BeNumerically("<=", NewCoordinates(5, 5).DistanceTo(reachable[i])))
+			}
+		})
+	})
+})